@@ -0,0 +1,68 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+
+	"github.com/andree-bjorkgard/remote-bluetooth/internal/certs"
+)
+
+const usage = `usage:
+  certs [-force] ca <dir>                             create a certificate authority in dir
+  certs [-force] cert <dir> <name>                    create a client certificate for name signed by the CA in dir
+  certs [-force] -server cert <dir> <name> [host...]  create a server certificate for name, valid for the hosts
+
+Existing files are only overwritten with -force.`
+
+func main() {
+	force := flag.Bool("force", false, "overwrite existing files")
+	server := flag.Bool("server", false, "create a server certificate")
+	flag.Usage = func() { fmt.Println(usage) }
+	flag.Parse()
+
+	args := flag.Args()
+	if len(args) < 2 {
+		fmt.Println(usage)
+		os.Exit(2)
+	}
+
+	dir := args[1]
+
+	switch args[0] {
+	case "ca":
+		if err := certs.GenerateCA(dir, *force); err != nil {
+			fatal(err)
+		}
+		log.Printf("Created CA in %s", dir)
+
+	case "cert":
+		if len(args) < 3 || (len(args) > 3 && !*server) {
+			fmt.Println(usage)
+			os.Exit(2)
+		}
+		name := args[2]
+		if err := certs.GenerateCertificate(dir, name, args[3:], *server, *force); err != nil {
+			fatal(err)
+		}
+		if *server {
+			log.Printf("Created server certificate for %s in %s", name, dir)
+		} else {
+			log.Printf("Created client certificate for %s in %s", name, dir)
+		}
+
+	default:
+		fmt.Println(usage)
+		os.Exit(2)
+	}
+}
+
+func fatal(err error) {
+	if errors.Is(err, fs.ErrExist) {
+		log.Fatalf("%s, use -force to overwrite", err)
+	}
+	log.Fatal(err)
+}
@@ -1,28 +1,58 @@
 package main
 
 import (
+	"context"
+	"fmt"
 	"log"
 
 	"github.com/andree-bjorkgard/remote-bluetooth/pkg/client"
 	"github.com/andree-bjorkgard/remote-bluetooth/pkg/config"
+	"github.com/andree-bjorkgard/remote-bluetooth/pkg/notify"
 )
 
 func main() {
 	cfg := config.NewConfig()
 	c := client.NewClient(cfg)
 
-	go c.FindServers()
-	for {
-		event := <-c.GetDeviceEventsChannel()
-		log.Printf("Server: %s, Device: %s, Battery: %s\n", event.Server, event.Device.Name, event.Device.BatteryStatus)
+	defer c.Close()
+
+	if n, err := notify.New("remote-bluetooth"); err != nil {
+		log.Println("Desktop notifications disabled: ", err)
+	} else {
+		defer n.Close()
+		c.OnLowBattery(n.LowBattery)
+	}
+
+	go func() {
+		if err := c.FindServers(context.Background()); err != nil {
+			log.Fatal(err)
+		}
+	}()
+	go func() {
+		for event := range c.GetServerEventsChannel() {
+			switch event.Type {
+			case client.ServerAdded:
+				log.Printf("Server added: %s (%s)\n", event.Server.Address, event.Server.Name)
+			case client.ServerLost:
+				log.Printf("Server lost: %s (%s)\n", event.Server.Address, event.Server.Name)
+			}
+		}
+	}()
+
+	for event := range c.GetDeviceEventsChannel() {
+		battery := "unknown"
+		if event.Device.Battery != nil {
+			battery = fmt.Sprintf("%d%%", event.Device.Battery.Percentage)
+		}
+		log.Printf("Server: %s, Device: %s, Battery: %s\n", event.Server, event.Device.Name, battery)
 		if event.Device.Address == "00:0A:45:19:F3:A6" {
 			if event.Device.Connected {
-				err := c.DisconnectFromDevice(event.Server, event.Device.Address)
+				err := c.DisconnectFromDevice(context.Background(), event.Server, event.Device.Address)
 				if err != nil {
 					log.Printf("Failed to disconnect device: %s\n", err)
 				}
 			} else {
-				err := c.ConnectToDevice(event.Server, event.Device.Address)
+				err := c.ConnectToDevice(context.Background(), event.Server, event.Device.Address)
 				if err != nil {
 					log.Printf("Failed to connect device: %s\n", err)
 				}
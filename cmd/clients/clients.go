@@ -0,0 +1,48 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/andree-bjorkgard/remote-bluetooth/internal/pairing"
+	"github.com/andree-bjorkgard/remote-bluetooth/pkg/config"
+)
+
+const usage = `usage:
+  clients list           list the clients paired with this server
+  clients revoke <name>  revoke the tokens of a client`
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Println(usage)
+		os.Exit(2)
+	}
+
+	cfg := config.NewConfig()
+	registry, err := pairing.LoadRegistry(cfg.ClientsFile)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	switch os.Args[1] {
+	case "list":
+		for _, c := range registry.List() {
+			fmt.Printf("%s\tpaired %s\n", c.Name, c.PairedAt.Format("2006-01-02 15:04"))
+		}
+
+	case "revoke":
+		if len(os.Args) < 3 {
+			fmt.Println(usage)
+			os.Exit(2)
+		}
+		if err := registry.Revoke(os.Args[2]); err != nil {
+			log.Fatal(err)
+		}
+		log.Printf("Revoked %s", os.Args[2])
+
+	default:
+		fmt.Println(usage)
+		os.Exit(2)
+	}
+}
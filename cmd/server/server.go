@@ -6,6 +6,7 @@ import (
 	"github.com/andree-bjorkgard/remote-bluetooth/internal/bluetooth"
 	"github.com/andree-bjorkgard/remote-bluetooth/internal/discovery"
 	"github.com/andree-bjorkgard/remote-bluetooth/pkg/config"
+	"github.com/andree-bjorkgard/remote-bluetooth/pkg/notify"
 	"github.com/sirupsen/logrus"
 )
 
@@ -17,7 +18,16 @@ func main() {
 
 	go discoveryService.StartServerAnnouncer(cfg.Port)
 
-	if err := bluetooth.NewBluetoothServer(cfg.Port, cfg.AdapterID).Start(); err != nil {
+	server := bluetooth.NewBluetoothServer(cfg.Port, cfg.AdapterID)
+	if n, err := notify.New("remote-bluetooth"); err != nil {
+		log.Println("Pairing codes are only logged, notifications are unavailable:", err)
+	} else {
+		defer n.Close()
+		server.SetPairingNotifier(n)
+	}
+	go server.FindPeers(discoveryService, cfg)
+
+	if err := server.Start(); err != nil {
 		log.Println(err)
 	}
 }
@@ -0,0 +1,153 @@
+// Package acl decides which clients may perform which operations on which devices.
+//
+// Rules are read from a JSON file and evaluated in order, the first matching rule decides:
+//
+//	{
+//	  "default": "allow",
+//	  "rules": [
+//	    {"client": "kids-tablet", "operations": ["ConnectToDevice"], "devices": ["Living room*"], "action": "allow"},
+//	    {"client": "kids-tablet", "action": "deny"}
+//	  ]
+//	}
+//
+// Clients, operations and devices are glob patterns as understood by path.Match. Devices are matched against
+// both the address and the name of a device, and a rule without devices matches every device.
+// Empty client or operations match everything.
+package acl
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+)
+
+const (
+	Allow = "allow"
+	Deny  = "deny"
+)
+
+type Rule struct {
+	Client     string   `json:"client"`
+	Operations []string `json:"operations"`
+	Devices    []string `json:"devices"`
+	Action     string   `json:"action"`
+}
+
+type ACL struct {
+	Default string `json:"default"`
+	Rules   []Rule `json:"rules"`
+}
+
+// Load reads the ACL at path. An empty path returns a nil ACL, which allows everything.
+func Load(filePath string) (*ACL, error) {
+	if filePath == "" {
+		return nil, nil
+	}
+
+	b, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("acl.Load: %w", err)
+	}
+
+	a := &ACL{Default: Allow}
+	if err := json.Unmarshal(b, a); err != nil {
+		return nil, fmt.Errorf("acl.Load: %w", err)
+	}
+
+	if err := a.validate(); err != nil {
+		return nil, fmt.Errorf("acl.Load: %w", err)
+	}
+
+	return a, nil
+}
+
+// AllowedOperation reports whether client may perform operation on at least some device,
+// which is all that is known before a device has been looked up.
+func (a *ACL) AllowedOperation(client, operation string) bool {
+	if a == nil {
+		return true
+	}
+
+	for _, r := range a.Rules {
+		if !r.matchesClient(client, operation) {
+			continue
+		}
+
+		if len(r.Devices) == 0 {
+			return r.Action == Allow
+		}
+		if r.Action == Allow {
+			return true
+		}
+	}
+
+	return a.Default != Deny
+}
+
+// Allowed reports whether client may perform operation on the device with the given address and name.
+func (a *ACL) Allowed(client, operation, address, name string) bool {
+	if a == nil {
+		return true
+	}
+
+	for _, r := range a.Rules {
+		if r.matchesClient(client, operation) && r.matchesDevice(address, name) {
+			return r.Action == Allow
+		}
+	}
+
+	return a.Default != Deny
+}
+
+func (r Rule) matchesClient(client, operation string) bool {
+	if r.Client != "" && !match(r.Client, client) {
+		return false
+	}
+
+	return len(r.Operations) == 0 || matchAny(r.Operations, operation)
+}
+
+func (r Rule) matchesDevice(address, name string) bool {
+	if len(r.Devices) == 0 {
+		return true
+	}
+
+	return matchAny(r.Devices, address) || (name != "" && matchAny(r.Devices, name))
+}
+
+func (a *ACL) validate() error {
+	if a.Default != Allow && a.Default != Deny {
+		return fmt.Errorf("invalid default %q", a.Default)
+	}
+
+	for i, r := range a.Rules {
+		if r.Action != Allow && r.Action != Deny {
+			return fmt.Errorf("rule %d: invalid action %q", i, r.Action)
+		}
+
+		patterns := append([]string{r.Client}, r.Operations...)
+		for _, p := range append(patterns, r.Devices...) {
+			if _, err := path.Match(p, ""); err != nil {
+				return fmt.Errorf("rule %d: invalid pattern %q", i, p)
+			}
+		}
+	}
+
+	return nil
+}
+
+func matchAny(patterns []string, s string) bool {
+	for _, p := range patterns {
+		if match(p, s) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func match(pattern, s string) bool {
+	ok, _ := path.Match(pattern, s)
+	return ok
+}
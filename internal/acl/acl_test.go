@@ -0,0 +1,126 @@
+package acl
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func loadTestACL(t *testing.T, content string) *ACL {
+	t.Helper()
+
+	file := filepath.Join(t.TempDir(), "acl.json")
+	if err := os.WriteFile(file, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	a, err := Load(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return a
+}
+
+func TestAllowed(t *testing.T) {
+	a := loadTestACL(t, `{
+		"default": "allow",
+		"rules": [
+			{"client": "kids-*", "operations": ["ConnectToDevice"], "devices": ["Living room*"], "action": "allow"},
+			{"client": "kids-*", "action": "deny"},
+			{"operations": ["Remove*"], "devices": ["AA:BB:*"], "action": "deny"},
+			{"operations": ["RemoveDevice"], "action": "allow"}
+		]
+	}`)
+
+	tests := []struct {
+		name      string
+		client    string
+		operation string
+		address   string
+		device    string
+		want      bool
+	}{
+		{"wildcard client and device", "kids-tablet", "ConnectToDevice", "11:22:33:44:55:66", "Living room speaker", true},
+		{"later deny for the same client", "kids-tablet", "ConnectToDevice", "11:22:33:44:55:66", "Headphones", false},
+		{"rule without operations", "kids-tablet", "ListDevices", "11:22:33:44:55:66", "Living room speaker", false},
+		{"default", "laptop", "ConnectToDevice", "11:22:33:44:55:66", "Headphones", true},
+		{"deny before allow", "laptop", "RemoveDevice", "AA:BB:CC:DD:EE:FF", "Headphones", false},
+		{"allow after unmatched deny", "laptop", "RemoveDevice", "11:22:33:44:55:66", "Headphones", true},
+		{"device matched by name", "laptop", "RemoveDevice", "11:22:33:44:55:66", "AA:BB:CC", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := a.Allowed(tt.client, tt.operation, tt.address, tt.device); got != tt.want {
+				t.Fatalf("Allowed = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDenyByDefault(t *testing.T) {
+	a := loadTestACL(t, `{
+		"default": "deny",
+		"rules": [
+			{"client": "laptop", "operations": ["ConnectToDevice"], "devices": ["Headphones"], "action": "allow"}
+		]
+	}`)
+
+	if !a.Allowed("laptop", "ConnectToDevice", "11:22:33:44:55:66", "Headphones") {
+		t.Fatal("matching allow rule was not applied")
+	}
+	if a.Allowed("laptop", "ConnectToDevice", "11:22:33:44:55:66", "Speaker") {
+		t.Fatal("unmatched device was allowed")
+	}
+
+	// An operation allowed on some device passes the check made before the device is known
+	if !a.AllowedOperation("laptop", "ConnectToDevice") {
+		t.Fatal("AllowedOperation denied an operation allowed on a device")
+	}
+	if a.AllowedOperation("laptop", "RemoveDevice") {
+		t.Fatal("AllowedOperation allowed an operation without any allow rule")
+	}
+}
+
+func TestAllowedOperationDenyPrecedence(t *testing.T) {
+	a := loadTestACL(t, `{
+		"default": "allow",
+		"rules": [
+			{"client": "kids-*", "operations": ["Remove*"], "action": "deny"},
+			{"client": "kids-*", "operations": ["RemoveDevice"], "devices": ["Toy*"], "action": "allow"}
+		]
+	}`)
+
+	// The deny rule without devices covers every device, so the later allow is never reached
+	if a.AllowedOperation("kids-tablet", "RemoveDevice") {
+		t.Fatal("AllowedOperation ignored the earlier deny rule")
+	}
+	if a.Allowed("kids-tablet", "RemoveDevice", "11:22:33:44:55:66", "Toy car") {
+		t.Fatal("Allowed ignored the earlier deny rule")
+	}
+	if !a.AllowedOperation("laptop", "RemoveDevice") {
+		t.Fatal("deny rule applied to another client")
+	}
+}
+
+func TestNilACL(t *testing.T) {
+	a, err := Load("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !a.Allowed("anyone", "RemoveDevice", "11:22:33:44:55:66", "Headphones") || !a.AllowedOperation("anyone", "RemoveDevice") {
+		t.Fatal("nil ACL denied an operation")
+	}
+}
+
+func TestLoadRejectsInvalidPatterns(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "acl.json")
+	if err := os.WriteFile(file, []byte(`{"rules": [{"devices": ["[AA"], "action": "deny"}]}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := Load(file); err == nil {
+		t.Fatal("Load accepted an invalid pattern")
+	}
+}
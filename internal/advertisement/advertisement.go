@@ -0,0 +1,48 @@
+// Package advertisement decodes the beacon and sensor payloads BLE devices broadcast in their
+// advertisements: iBeacon manufacturer data, Eddystone service data and BTHome v2 service data.
+package advertisement
+
+import "errors"
+
+const (
+	// AppleCompanyID is the manufacturer data key of iBeacons
+	AppleCompanyID = 0x004c
+	// EddystoneUUID is the service data key of Eddystone frames
+	EddystoneUUID = "0000feaa-0000-1000-8000-00805f9b34fb"
+	// BTHomeUUID is the service data key of BTHome v2 payloads
+	BTHomeUUID = "0000fcd2-0000-1000-8000-00805f9b34fb"
+)
+
+// ErrInvalidPayload is returned for data that is too short or not of the decoded kind
+var ErrInvalidPayload = errors.New("invalid payload")
+
+// Payloads are the payloads of an advertisement, nil for the kinds it does not carry
+type Payloads struct {
+	IBeacon   *IBeacon
+	Eddystone *Eddystone
+	BTHome    *BTHome
+}
+
+// Decode decodes every known payload of an advertisement. Service data is keyed by full lowercase UUIDs,
+// like BlueZ reports it. Data that does not decode is left out.
+func Decode(manufacturerData map[uint16][]byte, serviceData map[string][]byte) Payloads {
+	var p Payloads
+
+	if data, ok := manufacturerData[AppleCompanyID]; ok {
+		if b, err := ParseIBeacon(data); err == nil {
+			p.IBeacon = &b
+		}
+	}
+	if data, ok := serviceData[EddystoneUUID]; ok {
+		if e, err := ParseEddystone(data); err == nil {
+			p.Eddystone = &e
+		}
+	}
+	if data, ok := serviceData[BTHomeUUID]; ok {
+		if h, err := ParseBTHome(data); err == nil {
+			p.BTHome = &h
+		}
+	}
+
+	return p
+}
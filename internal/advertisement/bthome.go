@@ -0,0 +1,155 @@
+package advertisement
+
+import "fmt"
+
+// BTHome is a BTHome v2 payload
+type BTHome struct {
+	// Encrypted payloads are not decoded, they have no measurements
+	Encrypted bool
+	// TriggerBased devices only advertise when something happens, not at a regular interval
+	TriggerBased bool
+	// Measurements are in the order of the payload. A payload with an object that is not known is decoded
+	// up to that object, since the length of the rest cannot be known.
+	Measurements []Measurement
+}
+
+type Measurement struct {
+	// Name is the BTHome name of the object, e.g. temperature, humidity or door
+	Name  string
+	Value float64
+	// Unit is empty for counts, events and binary sensors, whose value is 1 for on, open or detected
+	Unit string
+}
+
+type bthomeObject struct {
+	name   string
+	size   int
+	signed bool
+	// divisor scales the raw value to the unit
+	divisor float64
+	unit    string
+}
+
+// The BTHome v2 objects that are decoded, by object id
+var bthomeObjects = map[byte]bthomeObject{
+	0x00: {"packet_id", 1, false, 1, ""},
+	0x01: {"battery", 1, false, 1, "%"},
+	0x02: {"temperature", 2, true, 100, "°C"},
+	0x03: {"humidity", 2, false, 100, "%"},
+	0x04: {"pressure", 3, false, 100, "hPa"},
+	0x05: {"illuminance", 3, false, 100, "lx"},
+	0x06: {"mass", 2, false, 100, "kg"},
+	0x07: {"mass", 2, false, 100, "lb"},
+	0x08: {"dewpoint", 2, true, 100, "°C"},
+	0x09: {"count", 1, false, 1, ""},
+	0x0a: {"energy", 3, false, 1000, "kWh"},
+	0x0b: {"power", 3, false, 100, "W"},
+	0x0c: {"voltage", 2, false, 1000, "V"},
+	0x0d: {"pm2_5", 2, false, 1, "µg/m³"},
+	0x0e: {"pm10", 2, false, 1, "µg/m³"},
+	0x0f: {"generic_boolean", 1, false, 1, ""},
+	0x10: {"power_on", 1, false, 1, ""},
+	0x11: {"opening", 1, false, 1, ""},
+	0x12: {"co2", 2, false, 1, "ppm"},
+	0x13: {"tvoc", 2, false, 1, "µg/m³"},
+	0x14: {"moisture", 2, false, 100, "%"},
+	0x15: {"battery_low", 1, false, 1, ""},
+	0x16: {"battery_charging", 1, false, 1, ""},
+	0x17: {"carbon_monoxide", 1, false, 1, ""},
+	0x18: {"cold", 1, false, 1, ""},
+	0x19: {"connectivity", 1, false, 1, ""},
+	0x1a: {"door", 1, false, 1, ""},
+	0x1b: {"garage_door", 1, false, 1, ""},
+	0x1c: {"gas", 1, false, 1, ""},
+	0x1d: {"heat", 1, false, 1, ""},
+	0x1e: {"light", 1, false, 1, ""},
+	0x1f: {"lock", 1, false, 1, ""},
+	0x20: {"moisture_detected", 1, false, 1, ""},
+	0x21: {"motion", 1, false, 1, ""},
+	0x22: {"moving", 1, false, 1, ""},
+	0x23: {"occupancy", 1, false, 1, ""},
+	0x24: {"plug", 1, false, 1, ""},
+	0x25: {"presence", 1, false, 1, ""},
+	0x26: {"problem", 1, false, 1, ""},
+	0x27: {"running", 1, false, 1, ""},
+	0x28: {"safety", 1, false, 1, ""},
+	0x29: {"smoke", 1, false, 1, ""},
+	0x2a: {"sound", 1, false, 1, ""},
+	0x2b: {"tamper", 1, false, 1, ""},
+	0x2c: {"vibration", 1, false, 1, ""},
+	0x2d: {"window", 1, false, 1, ""},
+	0x2e: {"humidity", 1, false, 1, "%"},
+	0x2f: {"moisture", 1, false, 1, "%"},
+	// The value is the event, 1 for a press, 2 for a double press and so on
+	0x3a: {"button", 1, false, 1, ""},
+	0x3d: {"count", 2, false, 1, ""},
+	0x3e: {"count", 4, false, 1, ""},
+	0x3f: {"rotation", 2, true, 10, "°"},
+	0x40: {"distance", 2, false, 1, "mm"},
+	0x41: {"distance", 2, false, 10, "m"},
+	0x42: {"duration", 3, false, 1000, "s"},
+	0x43: {"current", 2, false, 1000, "A"},
+	0x44: {"speed", 2, false, 100, "m/s"},
+	0x45: {"temperature", 2, true, 10, "°C"},
+	0x46: {"uv_index", 1, false, 10, ""},
+	0x47: {"volume", 2, false, 10, "L"},
+	0x48: {"volume", 2, false, 1, "mL"},
+	0x49: {"volume_flow_rate", 2, false, 1000, "m³/h"},
+	0x4a: {"voltage", 2, false, 10, "V"},
+	0x4b: {"gas", 3, false, 1000, "m³"},
+	0x4c: {"gas", 4, false, 1000, "m³"},
+	0x4d: {"energy", 4, false, 1000, "kWh"},
+	0x4e: {"volume", 4, false, 1000, "L"},
+	0x4f: {"water", 4, false, 1000, "L"},
+	0x50: {"timestamp", 4, false, 1, "s"},
+	0x51: {"acceleration", 2, false, 1000, "m/s²"},
+	0x52: {"gyroscope", 2, false, 1000, "°/s"},
+}
+
+// ParseBTHome decodes the BTHome service data of a sensor, which is a device information byte followed by
+// the objects, each an object id and a little endian value
+func ParseBTHome(data []byte) (BTHome, error) {
+	if len(data) < 1 {
+		return BTHome{}, fmt.Errorf("advertisement.ParseBTHome: %w", ErrInvalidPayload)
+	}
+	if version := data[0] >> 5; version != 2 {
+		return BTHome{}, fmt.Errorf("advertisement.ParseBTHome: %w: version %d", ErrInvalidPayload, version)
+	}
+
+	h := BTHome{
+		Encrypted:    data[0]&0x01 != 0,
+		TriggerBased: data[0]&0x04 != 0,
+	}
+	if h.Encrypted {
+		return h, nil
+	}
+
+	for rest := data[1:]; len(rest) > 0; {
+		obj, ok := bthomeObjects[rest[0]]
+		if !ok || len(rest) < 1+obj.size {
+			break
+		}
+
+		h.Measurements = append(h.Measurements, Measurement{
+			Name:  obj.name,
+			Value: float64(littleEndian(rest[1:1+obj.size], obj.signed)) / obj.divisor,
+			Unit:  obj.unit,
+		})
+		rest = rest[1+obj.size:]
+	}
+
+	return h, nil
+}
+
+// littleEndian reads an integer of up to 4 bytes
+func littleEndian(b []byte, signed bool) int64 {
+	var v uint64
+	for i := len(b) - 1; i >= 0; i-- {
+		v = v<<8 | uint64(b[i])
+	}
+	if signed && b[len(b)-1]&0x80 != 0 {
+		return int64(v) - 1<<(8*len(b))
+	}
+
+	return int64(v)
+}
@@ -0,0 +1,85 @@
+package advertisement
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestParseBTHome(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    []byte
+		want    BTHome
+		wantErr bool
+	}{
+		// The example of the BTHome v2 format description
+		{
+			name: "temperature and humidity",
+			data: []byte{0x40, 0x02, 0xca, 0x09, 0x03, 0xbf, 0x13},
+			want: BTHome{Measurements: []Measurement{{"temperature", 25.06, "°C"}, {"humidity", 50.55, "%"}}},
+		},
+		{
+			name: "negative temperature",
+			data: []byte{0x40, 0x02, 0x18, 0xfc},
+			want: BTHome{Measurements: []Measurement{{"temperature", -10, "°C"}}},
+		},
+		{
+			name: "negative rotation",
+			data: []byte{0x40, 0x3f, 0xfe, 0xff},
+			want: BTHome{Measurements: []Measurement{{"rotation", -0.2, "°"}}},
+		},
+		{
+			// Unsigned objects keep the high bit as part of the value
+			name: "large unsigned",
+			data: []byte{0x40, 0x12, 0xe8, 0xfd},
+			want: BTHome{Measurements: []Measurement{{"co2", 65000, "ppm"}}},
+		},
+		{
+			name: "packet id, battery and pressure",
+			data: []byte{0x40, 0x00, 0x09, 0x01, 0x61, 0x04, 0x13, 0x8a, 0x01},
+			want: BTHome{Measurements: []Measurement{{"packet_id", 9, ""}, {"battery", 97, "%"}, {"pressure", 1008.83, "hPa"}}},
+		},
+		{
+			name: "trigger based button",
+			data: []byte{0x44, 0x3a, 0x01},
+			want: BTHome{TriggerBased: true, Measurements: []Measurement{{"button", 1, ""}}},
+		},
+		{
+			name: "truncated object",
+			data: []byte{0x40, 0x01, 0x61, 0x02, 0xca},
+			want: BTHome{Measurements: []Measurement{{"battery", 97, "%"}}},
+		},
+		{
+			name: "unknown object",
+			data: []byte{0x40, 0x01, 0x61, 0xf0, 0x01, 0x02, 0xca, 0x09},
+			want: BTHome{Measurements: []Measurement{{"battery", 97, "%"}}},
+		},
+		{
+			name: "encrypted",
+			data: []byte{0x41, 0xa4, 0x72, 0x66, 0xc9, 0x5f, 0x73, 0x00, 0x11, 0x22, 0x33, 0x78, 0x23, 0x72, 0x14},
+			want: BTHome{Encrypted: true},
+		},
+		{name: "device information only", data: []byte{0x40}, want: BTHome{}},
+		{name: "version 1", data: []byte{0x20, 0x02, 0xca, 0x09}, wantErr: true},
+		{name: "empty", data: nil, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseBTHome(tt.data)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidPayload) {
+					t.Fatalf("err = %v, want ErrInvalidPayload", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("ParseBTHome = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
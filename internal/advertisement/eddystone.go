@@ -0,0 +1,127 @@
+package advertisement
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"time"
+)
+
+type EddystoneFrame int
+
+const (
+	EddystoneUID EddystoneFrame = iota
+	EddystoneURL
+	// EddystoneTLM frames carry the telemetry of the beacon
+	EddystoneTLM
+	// EddystoneEID frames carry a rotating ephemeral id
+	EddystoneEID
+)
+
+var eddystoneFrames = map[byte]EddystoneFrame{
+	0x00: EddystoneUID,
+	0x10: EddystoneURL,
+	0x20: EddystoneTLM,
+	0x30: EddystoneEID,
+}
+
+var urlSchemes = []string{"http://www.", "https://www.", "http://", "https://"}
+
+// Codes a URL frame uses for the common parts of a URL
+var urlExpansions = []string{".com/", ".org/", ".edu/", ".net/", ".info/", ".biz/", ".gov/", ".com", ".org", ".edu", ".net", ".info", ".biz", ".gov"}
+
+// Eddystone is a single Eddystone frame, only the fields of its frame type are set
+type Eddystone struct {
+	Frame EddystoneFrame
+	// TxPower is the calibrated RSSI at 0 m in dBm, of UID, URL and EID frames
+	TxPower int8
+
+	// Namespace and Instance are the 10 and 6 byte ids of UID frames
+	Namespace []byte
+	Instance  []byte
+
+	URL string
+
+	// BatteryVoltage is in mV, 0 if the beacon does not report it
+	BatteryVoltage uint16
+	// Temperature is in °C, nil if the beacon does not report it
+	Temperature *float64
+	// AdvertisementCount is the number of frames sent since the beacon booted
+	AdvertisementCount uint32
+	Uptime             time.Duration
+
+	EID []byte
+}
+
+// ParseEddystone decodes the Eddystone service data of a beacon. Encrypted TLM frames are not supported.
+func ParseEddystone(data []byte) (Eddystone, error) {
+	if len(data) < 2 {
+		return Eddystone{}, fmt.Errorf("advertisement.ParseEddystone: %w", ErrInvalidPayload)
+	}
+	frame, ok := eddystoneFrames[data[0]]
+	if !ok {
+		return Eddystone{}, fmt.Errorf("advertisement.ParseEddystone: %w: frame type 0x%02x", ErrInvalidPayload, data[0])
+	}
+
+	e := Eddystone{Frame: frame}
+	switch frame {
+	case EddystoneUID:
+		if len(data) < 18 {
+			return Eddystone{}, fmt.Errorf("advertisement.ParseEddystone: %w: short UID frame", ErrInvalidPayload)
+		}
+		e.TxPower = int8(data[1])
+		e.Namespace = append([]byte(nil), data[2:12]...)
+		e.Instance = append([]byte(nil), data[12:18]...)
+
+	case EddystoneURL:
+		if len(data) < 3 || int(data[2]) >= len(urlSchemes) {
+			return Eddystone{}, fmt.Errorf("advertisement.ParseEddystone: %w: invalid URL frame", ErrInvalidPayload)
+		}
+		e.TxPower = int8(data[1])
+		url, err := decodeURL(urlSchemes[data[2]], data[3:])
+		if err != nil {
+			return Eddystone{}, fmt.Errorf("advertisement.ParseEddystone: %w", err)
+		}
+		e.URL = url
+
+	case EddystoneTLM:
+		if len(data) < 14 || data[1] != 0 {
+			return Eddystone{}, fmt.Errorf("advertisement.ParseEddystone: %w: invalid TLM frame", ErrInvalidPayload)
+		}
+		e.BatteryVoltage = binary.BigEndian.Uint16(data[2:4])
+		// The temperature is signed 8.8 fixed point, 0x8000 when it is not supported
+		if temp := binary.BigEndian.Uint16(data[4:6]); temp != 0x8000 {
+			t := float64(int16(temp)) / 256
+			e.Temperature = &t
+		}
+		e.AdvertisementCount = binary.BigEndian.Uint32(data[6:10])
+		e.Uptime = time.Duration(binary.BigEndian.Uint32(data[10:14])) * 100 * time.Millisecond
+
+	case EddystoneEID:
+		if len(data) < 10 {
+			return Eddystone{}, fmt.Errorf("advertisement.ParseEddystone: %w: short EID frame", ErrInvalidPayload)
+		}
+		e.TxPower = int8(data[1])
+		e.EID = append([]byte(nil), data[2:10]...)
+	}
+
+	return e, nil
+}
+
+func decodeURL(scheme string, encoded []byte) (string, error) {
+	var b strings.Builder
+	b.WriteString(scheme)
+
+	for _, c := range encoded {
+		switch {
+		case int(c) < len(urlExpansions):
+			b.WriteString(urlExpansions[c])
+		case c > 0x20 && c < 0x7f:
+			b.WriteByte(c)
+		default:
+			return "", fmt.Errorf("%w: URL byte 0x%02x", ErrInvalidPayload, c)
+		}
+	}
+
+	return b.String(), nil
+}
@@ -0,0 +1,108 @@
+package advertisement
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseEddystone(t *testing.T) {
+	temperature := func(t float64) *float64 { return &t }
+
+	tests := []struct {
+		name    string
+		data    []byte
+		want    Eddystone
+		wantErr bool
+	}{
+		{
+			name: "UID",
+			data: []byte{0x00, 0xe7, 0x8b, 0x89, 0x7f, 0x0b, 0x2b, 0x7a, 0x0e, 0x6b, 0x0b, 0x4e, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x00, 0x00},
+			want: Eddystone{
+				Frame:     EddystoneUID,
+				TxPower:   -25,
+				Namespace: []byte{0x8b, 0x89, 0x7f, 0x0b, 0x2b, 0x7a, 0x0e, 0x6b, 0x0b, 0x4e},
+				Instance:  []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06},
+			},
+		},
+		{name: "truncated UID", data: []byte{0x00, 0xe7, 0x8b, 0x89, 0x7f, 0x0b, 0x2b, 0x7a, 0x0e, 0x6b, 0x0b, 0x4e, 0x01, 0x02, 0x03, 0x04, 0x05}, wantErr: true},
+		// The sample of the specification, https://goo.gl/S6zT6P
+		{
+			name: "URL without expansion",
+			data: append([]byte{0x10, 0xeb, 0x03}, "goo.gl/S6zT6P"...),
+			want: Eddystone{Frame: EddystoneURL, TxPower: -21, URL: "https://goo.gl/S6zT6P"},
+		},
+		{
+			name: "URL with expansions",
+			data: append(append([]byte{0x10, 0x00, 0x00}, "google"...), 0x07),
+			want: Eddystone{Frame: EddystoneURL, URL: "http://www.google.com"},
+		},
+		{
+			name: "URL with expansion in the middle",
+			data: append(append([]byte{0x10, 0xf6, 0x02}, "example"...), append([]byte{0x00}, "beacons"...)...),
+			want: Eddystone{Frame: EddystoneURL, TxPower: -10, URL: "http://example.com/beacons"},
+		},
+		{
+			name: "URL with every scheme expansion",
+			data: append(append([]byte{0x10, 0x00, 0x01}, "example"...), 0x0d),
+			want: Eddystone{Frame: EddystoneURL, URL: "https://www.example.gov"},
+		},
+		{name: "URL with unknown scheme", data: append([]byte{0x10, 0x00, 0x04}, "example"...), wantErr: true},
+		{name: "URL with invalid byte", data: []byte{0x10, 0x00, 0x02, 'a', 0x20}, wantErr: true},
+		{name: "truncated URL", data: []byte{0x10, 0x00}, wantErr: true},
+		{
+			name: "TLM",
+			data: []byte{0x20, 0x00, 0x0b, 0xb8, 0x19, 0x80, 0x00, 0x00, 0x00, 0x64, 0x00, 0x00, 0x03, 0xe8},
+			want: Eddystone{Frame: EddystoneTLM, BatteryVoltage: 3000, Temperature: temperature(25.5), AdvertisementCount: 100, Uptime: 100 * time.Second},
+		},
+		{
+			name: "TLM below freezing",
+			data: []byte{0x20, 0x00, 0x0b, 0xb8, 0xff, 0x80, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x01},
+			want: Eddystone{Frame: EddystoneTLM, BatteryVoltage: 3000, Temperature: temperature(-0.5), AdvertisementCount: 1, Uptime: 100 * time.Millisecond},
+		},
+		{
+			name: "TLM without temperature",
+			data: []byte{0x20, 0x00, 0x00, 0x00, 0x80, 0x00, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x01},
+			want: Eddystone{Frame: EddystoneTLM, AdvertisementCount: 1, Uptime: 100 * time.Millisecond},
+		},
+		{name: "TLM one byte short", data: []byte{0x20, 0x00, 0x0b, 0xb8, 0x19, 0x80, 0x00, 0x00, 0x00, 0x64, 0x00, 0x00, 0x03}, wantErr: true},
+		{name: "encrypted TLM", data: []byte{0x20, 0x01, 0x0b, 0xb8, 0x19, 0x80, 0x00, 0x00, 0x00, 0x64, 0x00, 0x00, 0x03, 0xe8}, wantErr: true},
+		{
+			name: "EID",
+			data: []byte{0x30, 0xe7, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08},
+			want: Eddystone{Frame: EddystoneEID, TxPower: -25, EID: []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08}},
+		},
+		{name: "truncated EID", data: []byte{0x30, 0xe7, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07}, wantErr: true},
+		{name: "unknown frame", data: []byte{0x40, 0x00, 0x00}, wantErr: true},
+		{name: "empty", data: nil, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseEddystone(tt.data)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidPayload) {
+					t.Fatalf("err = %v, want ErrInvalidPayload", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !sameEddystone(got, tt.want) {
+				t.Fatalf("ParseEddystone = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func sameEddystone(a, b Eddystone) bool {
+	if (a.Temperature == nil) != (b.Temperature == nil) || (a.Temperature != nil && *a.Temperature != *b.Temperature) {
+		return false
+	}
+
+	return a.Frame == b.Frame && a.TxPower == b.TxPower && bytes.Equal(a.Namespace, b.Namespace) &&
+		bytes.Equal(a.Instance, b.Instance) && a.URL == b.URL && a.BatteryVoltage == b.BatteryVoltage &&
+		a.AdvertisementCount == b.AdvertisementCount && a.Uptime == b.Uptime && bytes.Equal(a.EID, b.EID)
+}
@@ -0,0 +1,32 @@
+package advertisement
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// IBeacon is an Apple iBeacon
+type IBeacon struct {
+	// UUID identifies the deployment, e.g. f7826da6-4fa2-4e98-8024-bc5b71e0893e
+	UUID  string
+	Major uint16
+	Minor uint16
+	// TxPower is the calibrated RSSI at 1 m in dBm
+	TxPower int8
+}
+
+// ParseIBeacon decodes the Apple manufacturer data of an iBeacon, which is the type 0x02, the length 0x15
+// and then the UUID, major, minor and power
+func ParseIBeacon(data []byte) (IBeacon, error) {
+	if len(data) < 23 || data[0] != 0x02 || data[1] != 0x15 {
+		return IBeacon{}, fmt.Errorf("advertisement.ParseIBeacon: %w", ErrInvalidPayload)
+	}
+
+	u := data[2:18]
+	return IBeacon{
+		UUID:    fmt.Sprintf("%x-%x-%x-%x-%x", u[0:4], u[4:6], u[6:8], u[8:10], u[10:16]),
+		Major:   binary.BigEndian.Uint16(data[18:20]),
+		Minor:   binary.BigEndian.Uint16(data[20:22]),
+		TxPower: int8(data[22]),
+	}, nil
+}
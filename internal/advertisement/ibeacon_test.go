@@ -0,0 +1,47 @@
+package advertisement
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseIBeacon(t *testing.T) {
+	// The sample of the iBeacon specification, with the AirLocate UUID, and a beacon at -59 dBm
+	sample := []byte{
+		0x02, 0x15,
+		0xe2, 0xc5, 0x6d, 0xb5, 0xdf, 0xfb, 0x48, 0xd2, 0xb0, 0x60, 0xd0, 0xf5, 0xa7, 0x10, 0x96, 0xe0,
+		0x00, 0x01, 0x30, 0x39, 0xc5,
+	}
+
+	tests := []struct {
+		name    string
+		data    []byte
+		want    IBeacon
+		wantErr bool
+	}{
+		{name: "spec sample", data: sample, want: IBeacon{UUID: "e2c56db5-dffb-48d2-b060-d0f5a71096e0", Major: 1, Minor: 12345, TxPower: -59}},
+		{name: "trailing byte", data: append(append([]byte(nil), sample...), 0x00), want: IBeacon{UUID: "e2c56db5-dffb-48d2-b060-d0f5a71096e0", Major: 1, Minor: 12345, TxPower: -59}},
+		{name: "truncated", data: sample[:22], wantErr: true},
+		{name: "empty", data: nil, wantErr: true},
+		{name: "other apple type", data: append([]byte{0x10, 0x15}, sample[2:]...), wantErr: true},
+		{name: "wrong length byte", data: append([]byte{0x02, 0x14}, sample[2:]...), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseIBeacon(tt.data)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidPayload) {
+					t.Fatalf("err = %v, want ErrInvalidPayload", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Fatalf("ParseIBeacon = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,110 @@
+// Package battery records battery levels over time and estimates when a battery runs out.
+package battery
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// Estimates need the battery to have been discharging for a while, two close samples are mostly noise
+const minEstimateSpan = 10 * time.Minute
+
+type Sample struct {
+	Time       time.Time
+	Percentage byte
+}
+
+// History keeps the samples of every device for the retention period.
+type History struct {
+	mu        sync.Mutex
+	retention time.Duration
+	samples   map[string][]Sample
+}
+
+func NewHistory(retention time.Duration) *History {
+	return &History{retention: retention, samples: make(map[string][]Sample)}
+}
+
+// Record adds a sample and drops the samples of the device that are older than the retention period.
+func (h *History) Record(address string, percentage byte, at time.Time) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	samples := append(h.samples[address], Sample{Time: at, Percentage: percentage})
+
+	cutoff := at.Add(-h.retention)
+	i := sort.Search(len(samples), func(i int) bool { return !samples[i].Time.Before(cutoff) })
+	h.samples[address] = append([]Sample(nil), samples[i:]...)
+}
+
+// Samples returns the samples of the device, oldest first
+func (h *History) Samples(address string) []Sample {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return append([]Sample(nil), h.samples[address]...)
+}
+
+// Addresses returns the devices that have samples
+func (h *History) Addresses() []string {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	addrs := make([]string, 0, len(h.samples))
+	for addr := range h.samples {
+		addrs = append(addrs, addr)
+	}
+	sort.Strings(addrs)
+
+	return addrs
+}
+
+// TimeToEmpty estimates how long the battery lasts from the samples taken since it was last charged,
+// by fitting a line through them. ok is false while the battery is not discharging or there is too
+// little data.
+func TimeToEmpty(samples []Sample) (d time.Duration, ok bool) {
+	if len(samples) < 2 {
+		return 0, false
+	}
+
+	// Only the trailing discharge matters, a rise means the device was charged
+	start := len(samples) - 1
+	for start > 0 && samples[start-1].Percentage >= samples[start].Percentage {
+		start--
+	}
+	samples = samples[start:]
+	if len(samples) < 2 || samples[len(samples)-1].Time.Sub(samples[0].Time) < minEstimateSpan {
+		return 0, false
+	}
+
+	// Least squares fit of percentage over seconds since the first sample
+	var sumX, sumY, sumXY, sumXX float64
+	for _, s := range samples {
+		x := s.Time.Sub(samples[0].Time).Seconds()
+		y := float64(s.Percentage)
+		sumX += x
+		sumY += y
+		sumXY += x * y
+		sumXX += x * x
+	}
+	n := float64(len(samples))
+	denominator := n*sumXX - sumX*sumX
+	if denominator == 0 {
+		return 0, false
+	}
+	slope := (n*sumXY - sumX*sumY) / denominator
+	if slope >= 0 {
+		return 0, false
+	}
+
+	last := samples[len(samples)-1]
+	intercept := (sumY - slope*sumX) / n
+	lastX := last.Time.Sub(samples[0].Time).Seconds()
+	level := intercept + slope*lastX
+	if level <= 0 {
+		return 0, true
+	}
+
+	return time.Duration(level / -slope * float64(time.Second)), true
+}
@@ -0,0 +1,57 @@
+package battery
+
+import (
+	"testing"
+	"time"
+)
+
+// samplesEvery returns a sample per minute with the percentages
+func samplesEvery(percentages ...byte) []Sample {
+	start := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	samples := make([]Sample, len(percentages))
+	for i, p := range percentages {
+		samples[i] = Sample{Time: start.Add(time.Duration(i) * time.Minute), Percentage: p}
+	}
+
+	return samples
+}
+
+func TestTimeToEmpty(t *testing.T) {
+	tests := []struct {
+		name    string
+		samples []Sample
+		want    time.Duration
+		wantOK  bool
+	}{
+		{name: "empty history", samples: nil},
+		{name: "single sample", samples: samplesEvery(50)},
+		{name: "flat slope", samples: samplesEvery(50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50)},
+		{name: "rising slope", samples: samplesEvery(40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50)},
+		{name: "too short a discharge", samples: samplesEvery(50, 49, 48)},
+		{
+			name:    "discharging",
+			samples: samplesEvery(60, 59, 58, 57, 56, 55, 54, 53, 52, 51, 50),
+			want:    50 * time.Minute,
+			wantOK:  true,
+		},
+		{
+			name:    "charged in between",
+			samples: samplesEvery(10, 5, 60, 59, 58, 57, 56, 55, 54, 53, 52, 51, 50),
+			want:    50 * time.Minute,
+			wantOK:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := TimeToEmpty(tt.samples)
+			if ok != tt.wantOK {
+				t.Fatalf("ok = %t, want %t", ok, tt.wantOK)
+			}
+			if diff := got - tt.want; diff < -time.Second || diff > time.Second {
+				t.Fatalf("TimeToEmpty = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package battery
+
+import (
+	"sort"
+	"sync"
+)
+
+// rearmMargin is how far the battery has to be charged above a reported threshold before it is reported again,
+// so a level that flickers around a threshold is reported once
+const rearmMargin = 5
+
+// Thresholds reports when the battery of a device drops to or below one of the configured levels.
+// Every threshold is reported once per discharge, it is armed again once the battery is charged
+// rearmMargin above it.
+type Thresholds struct {
+	mu     sync.Mutex
+	levels []byte
+	// The lowest threshold reported per device
+	reported map[string]byte
+}
+
+func NewThresholds(levels []byte) *Thresholds {
+	levels = append([]byte(nil), levels...)
+	sort.Slice(levels, func(i, j int) bool { return levels[i] < levels[j] })
+
+	return &Thresholds{levels: levels, reported: make(map[string]byte)}
+}
+
+// Check returns the threshold the battery just dropped to, ok is false if no new threshold was crossed.
+func (t *Thresholds) Check(address string, percentage byte) (threshold byte, ok bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if reported, ok := t.reported[address]; ok {
+		// The lowest threshold that is not yet charged far enough above to be armed again
+		i := sort.Search(len(t.levels), func(i int) bool { return int(t.levels[i])+rearmMargin > int(percentage) })
+		switch {
+		case i == len(t.levels):
+			delete(t.reported, address)
+		case t.levels[i] > reported:
+			t.reported[address] = t.levels[i]
+		}
+	}
+
+	// The lowest threshold the level is at or below
+	i := sort.Search(len(t.levels), func(i int) bool { return t.levels[i] >= percentage })
+	if i == len(t.levels) {
+		return 0, false
+	}
+	threshold = t.levels[i]
+
+	if reported, ok := t.reported[address]; ok && reported <= threshold {
+		return 0, false
+	}
+
+	t.reported[address] = threshold
+	return threshold, true
+}
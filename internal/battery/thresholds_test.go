@@ -0,0 +1,47 @@
+package battery
+
+import "testing"
+
+func TestThresholds(t *testing.T) {
+	type check struct {
+		percentage byte
+		want       byte
+		wantOK     bool
+	}
+
+	tests := []struct {
+		name   string
+		checks []check
+	}{
+		{"above every threshold", []check{{50, 0, false}, {21, 0, false}}},
+		{"reported once per discharge", []check{{25, 0, false}, {20, 20, true}, {19, 0, false}, {15, 0, false}}},
+		{"every crossed threshold", []check{{20, 20, true}, {10, 10, true}, {5, 5, true}, {1, 0, false}}},
+		{"skipped thresholds", []check{{50, 0, false}, {8, 10, true}, {5, 5, true}}},
+		{"flickering around a threshold", []check{{20, 20, true}, {21, 0, false}, {20, 0, false}, {24, 0, false}, {20, 0, false}}},
+		{"charged above the margin", []check{{20, 20, true}, {25, 0, false}, {20, 20, true}}},
+		{"partly charged", []check{{10, 10, true}, {16, 0, false}, {10, 10, true}, {19, 0, false}, {20, 0, false}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			th := NewThresholds([]byte{5, 20, 10})
+			for i, c := range tt.checks {
+				got, ok := th.Check("AA:AA:AA:AA:AA:01", c.percentage)
+				if got != c.want || ok != c.wantOK {
+					t.Fatalf("check %d at %d%% = %d, %t, want %d, %t", i, c.percentage, got, ok, c.want, c.wantOK)
+				}
+			}
+		})
+	}
+}
+
+func TestThresholdsPerDevice(t *testing.T) {
+	th := NewThresholds([]byte{20})
+
+	if _, ok := th.Check("AA:AA:AA:AA:AA:01", 15); !ok {
+		t.Fatal("first device not reported")
+	}
+	if _, ok := th.Check("AA:AA:AA:AA:AA:02", 15); !ok {
+		t.Fatal("second device not reported after the first")
+	}
+}
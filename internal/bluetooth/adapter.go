@@ -0,0 +1,428 @@
+package bluetooth
+
+import (
+	"context"
+	"errors"
+	"log"
+	"path"
+	"strings"
+
+	"github.com/muka/go-bluetooth/api"
+	"github.com/muka/go-bluetooth/bluez"
+	"github.com/muka/go-bluetooth/bluez/profile/adapter"
+	"github.com/muka/go-bluetooth/bluez/profile/battery"
+	"github.com/muka/go-bluetooth/bluez/profile/device"
+	"github.com/muka/go-bluetooth/bluez/profile/input"
+	"github.com/muka/go-bluetooth/bluez/profile/media"
+	"github.com/muka/go-bluetooth/bluez/profile/network"
+)
+
+var ErrDeviceNotFound = errors.New("device not found")
+
+// Adapter is the part of a Bluetooth adapter the server depends on.
+type Adapter interface {
+	// GetID returns the id of the adapter, e.g. hci0
+	GetID() string
+	GetAddress() (string, error)
+	GetName() (string, error)
+	GetAlias() (string, error)
+	GetPowered() (bool, error)
+	GetDiscoverable() (bool, error)
+	GetDiscoverableTimeout() (uint32, error)
+	GetPairable() (bool, error)
+	GetDiscovering() (bool, error)
+
+	SetPowered(powered bool) error
+	SetDiscoverable(discoverable bool) error
+	// SetDiscoverableTimeout sets the seconds after which the adapter stops being discoverable, 0 never stops
+	SetDiscoverableTimeout(seconds uint32) error
+	SetPairable(pairable bool) error
+	SetAlias(alias string) error
+
+	GetDevices() ([]Device, error)
+	GetDeviceByAddress(address string) (Device, error)
+	RemoveDevice(address string) error
+
+	// WatchDevices sends every device the adapter gets on added and the address of every device it loses
+	// on removed, until stop is called.
+	WatchDevices() (added <-chan Device, removed <-chan string, stop func(), err error)
+
+	// Discover starts discovery and sends every device that is found or whose RSSI changes on the
+	// returned channel. Calling stop ends discovery and closes the channel.
+	Discover() (found <-chan Device, stop func(), err error)
+
+	// Observe scans for LE advertisements and sends every advertisement that is received on the returned
+	// channel, without connecting to the devices. Calling stop ends the scan and closes the channel.
+	Observe(filter ObserveFilter) (ads <-chan Advertisement, stop func(), err error)
+
+	// RegisterAgent makes agent answer the prompts shown while pairing, until unregister is called.
+	RegisterAgent(agent Agent) (unregister func(), err error)
+}
+
+// Agent answers the prompts BlueZ shows while pairing a device. Request methods block until the prompt
+// is answered, a rejected prompt returns an error.
+type Agent interface {
+	RequestPinCode(dev Device) (string, error)
+	DisplayPinCode(dev Device, pinCode string)
+	RequestPasskey(dev Device) (uint32, error)
+	DisplayPasskey(dev Device, passkey uint32, entered uint16)
+	RequestConfirmation(dev Device, passkey uint32) error
+	RequestAuthorization(dev Device) error
+	AuthorizeService(dev Device, uuid string) error
+
+	// Cancel aborts every prompt that is still waiting for an answer
+	Cancel()
+}
+
+// Device is the part of a remote Bluetooth device the server depends on.
+type Device interface {
+	GetAddress() (string, error)
+	GetName() (string, error)
+	GetAlias() (string, error)
+	GetClass() (uint32, error)
+	GetBlocked() (bool, error)
+	GetAppearance() (uint16, error)
+	GetTxPower() (int16, error)
+	GetLegacyPairing() (bool, error)
+	GetModalias() (string, error)
+	GetAdapterID() (string, error)
+	GetTrusted() (bool, error)
+	GetPaired() (bool, error)
+	GetConnected() (bool, error)
+	GetIcon() (string, error)
+	GetUUIDs() ([]string, error)
+	GetBatteryPercentage() (byte, error)
+	GetBatterySource() (string, error)
+	GetRSSI() (int16, error)
+	// GetConnectedProfiles returns the UUIDs of the profiles that are connected
+	GetConnectedProfiles() ([]string, error)
+
+	SetTrusted(trusted bool) error
+
+	// Connect, Disconnect and Pair abort the pending call when ctx is done
+	Connect(ctx context.Context) error
+	Disconnect(ctx context.Context) error
+	Pair(ctx context.Context) error
+	// ConnectProfile and DisconnectProfile only connect or disconnect the profile with the UUID
+	ConnectProfile(ctx context.Context, uuid string) error
+	DisconnectProfile(ctx context.Context, uuid string) error
+	// GetMediaPlayer returns the player of the device, or ErrNoMediaPlayer unless AVRCP is connected
+	// and the device is playing or has played something
+	GetMediaPlayer() (MediaPlayer, error)
+	// GetMediaTransports returns the audio streams of the device, none while it is disconnected
+	GetMediaTransports() ([]MediaTransport, error)
+	// GetGattServices returns the GATT services of the device, none until it is connected and its
+	// services are resolved
+	GetGattServices() ([]GattService, error)
+
+	// WatchChanges signals on the returned channel whenever a property of the device changes.
+	// Calling stop ends the subscription.
+	WatchChanges() (changes <-chan struct{}, stop func(), err error)
+}
+
+type bluezAdapter struct {
+	adapter *adapter.Adapter1
+}
+
+var _ Adapter = (*bluezAdapter)(nil)
+
+// NewBlueZAdapter returns the BlueZ adapter with the given id, or the default adapter if id is empty.
+func NewBlueZAdapter(adapterID string) (Adapter, error) {
+	var a *adapter.Adapter1
+	var err error
+
+	if adapterID == "" {
+		a, err = api.GetDefaultAdapter()
+	} else {
+		a, err = api.GetAdapter(adapterID)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &bluezAdapter{adapter: a}, nil
+}
+
+func (a *bluezAdapter) GetID() string {
+	return path.Base(string(a.adapter.Path()))
+}
+
+func (a *bluezAdapter) GetAddress() (string, error) {
+	return a.adapter.GetAddress()
+}
+
+func (a *bluezAdapter) GetName() (string, error) {
+	return a.adapter.GetName()
+}
+
+func (a *bluezAdapter) GetAlias() (string, error) {
+	return a.adapter.GetAlias()
+}
+
+func (a *bluezAdapter) GetPowered() (bool, error) {
+	return a.adapter.GetPowered()
+}
+
+func (a *bluezAdapter) GetDiscoverable() (bool, error) {
+	return a.adapter.GetDiscoverable()
+}
+
+func (a *bluezAdapter) GetDiscoverableTimeout() (uint32, error) {
+	return a.adapter.GetDiscoverableTimeout()
+}
+
+func (a *bluezAdapter) GetPairable() (bool, error) {
+	return a.adapter.GetPairable()
+}
+
+func (a *bluezAdapter) GetDiscovering() (bool, error) {
+	return a.adapter.GetDiscovering()
+}
+
+func (a *bluezAdapter) SetPowered(powered bool) error {
+	return a.adapter.SetPowered(powered)
+}
+
+func (a *bluezAdapter) SetDiscoverable(discoverable bool) error {
+	return a.adapter.SetDiscoverable(discoverable)
+}
+
+func (a *bluezAdapter) SetDiscoverableTimeout(seconds uint32) error {
+	return a.adapter.SetDiscoverableTimeout(seconds)
+}
+
+func (a *bluezAdapter) SetPairable(pairable bool) error {
+	return a.adapter.SetPairable(pairable)
+}
+
+func (a *bluezAdapter) SetAlias(alias string) error {
+	return a.adapter.SetAlias(alias)
+}
+
+func (a *bluezAdapter) GetDevices() ([]Device, error) {
+	rawDevs, err := a.adapter.GetDevices()
+	if err != nil {
+		return nil, err
+	}
+
+	var devs []Device
+	for _, rd := range rawDevs {
+		if rd != nil {
+			devs = append(devs, &bluezDevice{rd})
+		}
+	}
+
+	return devs, nil
+}
+
+func (a *bluezAdapter) GetDeviceByAddress(address string) (Device, error) {
+	dev, err := a.adapter.GetDeviceByAddress(address)
+	if err != nil {
+		return nil, err
+	}
+	if dev == nil {
+		return nil, ErrDeviceNotFound
+	}
+
+	return &bluezDevice{dev}, nil
+}
+
+func (a *bluezAdapter) RemoveDevice(address string) error {
+	dev, err := a.adapter.GetDeviceByAddress(address)
+	if err != nil {
+		return err
+	}
+	if dev == nil {
+		return ErrDeviceNotFound
+	}
+
+	return a.adapter.RemoveDevice(dev.Path())
+}
+
+type bluezDevice struct {
+	*device.Device1
+}
+
+var _ Device = (*bluezDevice)(nil)
+
+func (d *bluezDevice) Connect(ctx context.Context) error {
+	err := d.call(ctx, "Connect")
+	if ctx.Err() != nil {
+		// Disconnect also cancels a Connect BlueZ is still working on
+		go func() {
+			if err := d.Device1.Disconnect(); err != nil {
+				log.Println("Error cancelling connect:", err)
+			}
+		}()
+	}
+
+	return err
+}
+
+func (d *bluezDevice) Disconnect(ctx context.Context) error {
+	return d.call(ctx, "Disconnect")
+}
+
+func (d *bluezDevice) Pair(ctx context.Context) error {
+	err := d.call(ctx, "Pair")
+	if ctx.Err() != nil {
+		go func() {
+			if err := d.CancelPairing(); err != nil {
+				log.Println("Error cancelling pairing:", err)
+			}
+		}()
+	}
+
+	return err
+}
+
+func (d *bluezDevice) ConnectProfile(ctx context.Context, uuid string) error {
+	err := d.call(ctx, "ConnectProfile", uuid)
+	if ctx.Err() != nil {
+		go func() {
+			if err := d.Device1.DisconnectProfile(uuid); err != nil {
+				log.Println("Error cancelling profile connect:", err)
+			}
+		}()
+	}
+
+	return err
+}
+
+func (d *bluezDevice) DisconnectProfile(ctx context.Context, uuid string) error {
+	return d.call(ctx, "DisconnectProfile", uuid)
+}
+
+// GetConnectedProfiles derives the connected profiles from the objects BlueZ only has while they are:
+// a MediaTransport1 for every audio stream, MediaControl1 for AVRCP, Network1 for PAN and Input1 for HID.
+func (d *bluezDevice) GetConnectedProfiles() ([]string, error) {
+	if connected, err := d.GetConnected(); err != nil || !connected {
+		return nil, err
+	}
+
+	om, err := bluez.GetObjectManager()
+	if err != nil {
+		return nil, err
+	}
+	objects, err := om.GetManagedObjects()
+	if err != nil {
+		return nil, err
+	}
+
+	var uuids []string
+	add := func(uuid string) {
+		uuid = strings.ToLower(uuid)
+		if uuid != "" && !contains(uuids, uuid) {
+			uuids = append(uuids, uuid)
+		}
+	}
+
+	prefix := string(d.Path()) + "/"
+	for p, ifaces := range objects {
+		if !strings.HasPrefix(string(p), prefix) {
+			continue
+		}
+		if t, ok := ifaces[media.MediaTransport1Interface]; ok {
+			uuid, _ := t["UUID"].Value().(string)
+			add(uuid)
+		}
+	}
+
+	ifaces := objects[d.Path()]
+	if c, ok := ifaces[media.MediaControl1Interface]; ok {
+		if connected, _ := c["Connected"].Value().(bool); connected {
+			add(uuid16(0x110e))
+		}
+	}
+	if n, ok := ifaces[network.Network1Interface]; ok {
+		if connected, _ := n["Connected"].Value().(bool); connected {
+			uuid, _ := n["UUID"].Value().(string)
+			add(uuid)
+		}
+	}
+	if _, ok := ifaces[input.Input1Interface]; ok {
+		// Classic HID and HID over GATT both show up as Input1
+		hid := uuid16(0x1124)
+		if advertised, _ := d.GetUUIDs(); !contains(advertised, hid) && contains(advertised, uuid16(0x1812)) {
+			hid = uuid16(0x1812)
+		}
+		add(hid)
+	}
+
+	return uuids, nil
+}
+
+// call calls a Device1 method, giving up on the reply once ctx is done
+func (d *bluezDevice) call(ctx context.Context, method string, args ...interface{}) error {
+	obj := d.Client().GetDbusObject()
+	if obj == nil {
+		if err := d.Client().Connect(); err != nil {
+			return err
+		}
+		obj = d.Client().GetDbusObject()
+	}
+
+	return obj.CallWithContext(ctx, device.Device1Interface+"."+method, 0, args...).Store()
+}
+
+func (d *bluezDevice) GetAdapterID() (string, error) {
+	p, err := d.GetAdapter()
+	if err != nil {
+		return "", err
+	}
+
+	return path.Base(string(p)), nil
+}
+
+func (d *bluezDevice) GetBatteryPercentage() (byte, error) {
+	b, err := battery.NewBattery1(d.Path())
+	if err != nil {
+		return 0, err
+	}
+
+	return b.GetPercentage()
+}
+
+func (d *bluezDevice) GetBatterySource() (string, error) {
+	b, err := battery.NewBattery1(d.Path())
+	if err != nil {
+		return "", err
+	}
+
+	return b.GetSource()
+}
+
+// WatchChanges subscribes to BlueZ PropertiesChanged signals for the device.
+// Battery1 and MediaControl1 live on the same object path as Device1, so a single subscription covers them.
+func (d *bluezDevice) WatchChanges() (<-chan struct{}, func(), error) {
+	ch, err := d.WatchProperties()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// A pending signal already means "something changed", so further changes are coalesced
+	changes := make(chan struct{}, 1)
+	go func() {
+		for p := range ch {
+			if p == nil {
+				return
+			}
+			if p.Interface != device.Device1Interface && p.Interface != battery.Battery1Interface &&
+				p.Interface != media.MediaControl1Interface {
+				continue
+			}
+
+			select {
+			case changes <- struct{}{}:
+			default:
+			}
+		}
+	}()
+
+	return changes, func() {
+		if err := d.UnwatchProperties(ch); err != nil {
+			log.Println("Error unwatching device properties:", err)
+		}
+	}, nil
+}
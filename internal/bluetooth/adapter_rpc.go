@@ -0,0 +1,166 @@
+package bluetooth
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	btgrpc "github.com/andree-bjorkgard/remote-bluetooth/internal/bluetooth/grpc"
+)
+
+const (
+	// powerOnTimeout bounds waiting for an adapter to report Powered after it was switched on
+	powerOnTimeout      = 5 * time.Second
+	powerOnPollInterval = 50 * time.Millisecond
+)
+
+// ListAdapters returns every adapter of the server, the default adapter first
+func (s *BluetoothServer) ListAdapters(_ context.Context, _ *btgrpc.Empty) (*btgrpc.Adapters, error) {
+	adapters, _ := s.adapters.list("")
+
+	resp := &btgrpc.Adapters{}
+	for _, a := range adapters {
+		ga, err := grpcAdapter(a)
+		if err != nil {
+			return nil, err
+		}
+		resp.Adapters = append(resp.Adapters, ga)
+	}
+
+	return resp, nil
+}
+
+func (s *BluetoothServer) GetAdapter(_ context.Context, request *btgrpc.AdapterRequest) (*btgrpc.Adapter, error) {
+	return s.onAdapter(request.AdapterId, func(_ Adapter) error {
+		return nil
+	})
+}
+
+func (s *BluetoothServer) SetPowered(_ context.Context, request *btgrpc.SetPoweredRequest) (*btgrpc.Adapter, error) {
+	return s.onAdapter(request.AdapterId, func(a Adapter) error {
+		return a.SetPowered(request.Powered)
+	})
+}
+
+// SetDiscoverable sets the timeout after which the adapter stops being discoverable first, since BlueZ
+// starts the timer when Discoverable is set
+func (s *BluetoothServer) SetDiscoverable(_ context.Context, request *btgrpc.SetDiscoverableRequest) (*btgrpc.Adapter, error) {
+	return s.onAdapter(request.AdapterId, func(a Adapter) error {
+		if request.Discoverable {
+			if err := a.SetDiscoverableTimeout(request.Timeout); err != nil {
+				return err
+			}
+		}
+		return a.SetDiscoverable(request.Discoverable)
+	})
+}
+
+func (s *BluetoothServer) SetPairable(_ context.Context, request *btgrpc.SetPairableRequest) (*btgrpc.Adapter, error) {
+	return s.onAdapter(request.AdapterId, func(a Adapter) error {
+		return a.SetPairable(request.Pairable)
+	})
+}
+
+// SetAlias sets the name other devices see, an empty alias resets it to the system name
+func (s *BluetoothServer) SetAlias(_ context.Context, request *btgrpc.SetAliasRequest) (*btgrpc.Adapter, error) {
+	return s.onAdapter(request.AdapterId, func(a Adapter) error {
+		return a.SetAlias(request.Alias)
+	})
+}
+
+// onAdapter runs fn on the adapter with the id, or the default adapter, and returns its new state
+func (s *BluetoothServer) onAdapter(adapterID string, fn func(a Adapter) error) (*btgrpc.Adapter, error) {
+	a, err := s.adapters.get(adapterID)
+	if err != nil {
+		return nil, deviceError(err, "")
+	}
+
+	if err := fn(a); err != nil {
+		return nil, deviceError(err, "")
+	}
+
+	return grpcAdapter(a)
+}
+
+// ensurePowered fails with ADAPTER_NOT_READY if the adapter is off, unless powerOn is set and the
+// client may power it on
+func (s *BluetoothServer) ensurePowered(ctx context.Context, adapter Adapter, powerOn bool) error {
+	powered, err := adapter.GetPowered()
+	if err != nil {
+		return deviceError(err, "")
+	}
+	if powered {
+		return nil
+	}
+
+	if !powerOn {
+		return newStatus(codes.FailedPrecondition, ReasonAdapterNotReady, map[string]string{"adapterId": adapter.GetID()},
+			fmt.Sprintf("adapter %s is powered off", adapter.GetID()))
+	}
+	if !s.acl.AllowedOperation(clientIdentity(ctx), "SetPowered") {
+		return operationDenied("SetPowered", clientIdentity(ctx))
+	}
+
+	if err := adapter.SetPowered(true); err != nil {
+		return deviceError(err, "")
+	}
+
+	return waitPowered(ctx, adapter)
+}
+
+// waitPowered waits until the adapter reports being powered, which the controller may take a moment for
+func waitPowered(ctx context.Context, adapter Adapter) error {
+	wctx, cancel := context.WithTimeout(ctx, powerOnTimeout)
+	defer cancel()
+
+	ticker := time.NewTicker(powerOnPollInterval)
+	defer ticker.Stop()
+
+	for {
+		powered, err := adapter.GetPowered()
+		if err != nil {
+			return deviceError(err, "")
+		}
+		if powered {
+			return nil
+		}
+
+		select {
+		case <-wctx.Done():
+			if ctx.Err() != nil {
+				return deviceError(ctx.Err(), "")
+			}
+			return newStatus(codes.FailedPrecondition, ReasonAdapterNotReady, map[string]string{"adapterId": adapter.GetID()},
+				fmt.Sprintf("adapter %s did not power on", adapter.GetID()))
+		case <-ticker.C:
+		}
+	}
+}
+
+func grpcAdapter(a Adapter) (*btgrpc.Adapter, error) {
+	address, err := a.GetAddress()
+	if err != nil {
+		return nil, deviceError(err, "")
+	}
+	name, _ := a.GetName()
+	alias, _ := a.GetAlias()
+	powered, _ := a.GetPowered()
+	discoverable, _ := a.GetDiscoverable()
+	discoverableTimeout, _ := a.GetDiscoverableTimeout()
+	pairable, _ := a.GetPairable()
+	discovering, _ := a.GetDiscovering()
+
+	return &btgrpc.Adapter{
+		Id:                  a.GetID(),
+		Address:             address,
+		Name:                name,
+		Alias:               alias,
+		Powered:             powered,
+		Discoverable:        discoverable,
+		DiscoverableTimeout: discoverableTimeout,
+		Pairable:            pairable,
+		Discovering:         discovering,
+	}, nil
+}
@@ -0,0 +1,546 @@
+package bluetooth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"path"
+	"sort"
+	"sync"
+
+	"github.com/godbus/dbus/v5"
+	"github.com/muka/go-bluetooth/bluez"
+	"github.com/muka/go-bluetooth/bluez/profile/adapter"
+)
+
+var (
+	ErrAdapterNotFound = errors.New("adapter not found")
+	// ErrBluetoothUnavailable is returned while the Bluetooth service is not running
+	ErrBluetoothUnavailable = fmt.Errorf("bluetooth service unavailable: %w", ErrDeviceUnavailable)
+)
+
+// AdapterSource lists the adapters of the system and reports adapters being plugged in and removed.
+type AdapterSource interface {
+	Adapters() ([]Adapter, error)
+
+	// WatchAdapters sends every adapter that appears on added and the id of every adapter that
+	// disappears on removed, until stop is called.
+	WatchAdapters() (added <-chan Adapter, removed <-chan string, stop func(), err error)
+
+	// WatchService sends true whenever the Bluetooth service starts and false whenever it stops, until
+	// stop is called. The adapters returned before the service stopped are stale.
+	WatchService() (running <-chan bool, stop func(), err error)
+}
+
+type bluezAdapterSource struct{}
+
+var _ AdapterSource = bluezAdapterSource{}
+
+// NewBlueZAdapterSource returns the adapters BlueZ manages, following InterfacesAdded and InterfacesRemoved.
+func NewBlueZAdapterSource() AdapterSource {
+	return bluezAdapterSource{}
+}
+
+func (bluezAdapterSource) Adapters() ([]Adapter, error) {
+	om, err := bluez.GetObjectManager()
+	if err != nil {
+		return nil, err
+	}
+
+	objects, err := om.GetManagedObjects()
+	if err != nil {
+		return nil, err
+	}
+
+	var adapters []Adapter
+	for p, ifaces := range objects {
+		if _, ok := ifaces[adapter.Adapter1Interface]; !ok {
+			continue
+		}
+
+		a, err := adapter.NewAdapter1(p)
+		if err != nil {
+			return nil, err
+		}
+		adapters = append(adapters, &bluezAdapter{adapter: a})
+	}
+
+	return adapters, nil
+}
+
+func (bluezAdapterSource) WatchAdapters() (<-chan Adapter, <-chan string, func(), error) {
+	om, err := bluez.GetObjectManager()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	signals, err := om.Register()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	added := make(chan Adapter)
+	removed := make(chan string)
+	done := make(chan struct{})
+
+	go func() {
+		for {
+			var sig *dbus.Signal
+			select {
+			case <-done:
+				return
+			case sig = <-signals:
+			}
+			if sig == nil || len(sig.Body) < 2 {
+				continue
+			}
+			p, _ := sig.Body[0].(dbus.ObjectPath)
+
+			switch sig.Name {
+			case bluez.InterfacesAdded:
+				ifaces, _ := sig.Body[1].(map[string]map[string]dbus.Variant)
+				if _, ok := ifaces[adapter.Adapter1Interface]; !ok {
+					continue
+				}
+				a, err := adapter.NewAdapter1(p)
+				if err != nil {
+					log.Println("Error adding adapter:", err)
+					continue
+				}
+				select {
+				case added <- &bluezAdapter{adapter: a}:
+				case <-done:
+					return
+				}
+			case bluez.InterfacesRemoved:
+				ifaces, _ := sig.Body[1].([]string)
+				if !contains(ifaces, adapter.Adapter1Interface) {
+					continue
+				}
+				select {
+				case removed <- path.Base(string(p)):
+				case <-done:
+					return
+				}
+			}
+		}
+	}()
+
+	var once sync.Once
+	stop := func() {
+		once.Do(func() {
+			close(done)
+			if err := om.Unregister(signals); err != nil {
+				log.Println("Error unregistering adapter watch:", err)
+			}
+		})
+	}
+
+	return added, removed, stop, nil
+}
+
+// WatchService follows the owner of the org.bluez name on the system bus
+func (bluezAdapterSource) WatchService() (<-chan bool, func(), error) {
+	conn, err := bluez.GetConnection(bluez.SystemBus)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	match := []dbus.MatchOption{
+		dbus.WithMatchInterface("org.freedesktop.DBus"),
+		dbus.WithMatchMember("NameOwnerChanged"),
+		dbus.WithMatchOption("arg0", bluez.OrgBluezInterface),
+	}
+	if err := conn.AddMatchSignal(match...); err != nil {
+		return nil, nil, err
+	}
+
+	signals := make(chan *dbus.Signal, 10)
+	conn.Signal(signals)
+
+	running := make(chan bool)
+	done := make(chan struct{})
+
+	go func() {
+		for {
+			var sig *dbus.Signal
+			select {
+			case <-done:
+				return
+			case sig = <-signals:
+			}
+			if sig == nil || sig.Name != "org.freedesktop.DBus.NameOwnerChanged" || len(sig.Body) < 3 {
+				continue
+			}
+			if name, _ := sig.Body[0].(string); name != bluez.OrgBluezInterface {
+				continue
+			}
+			newOwner, _ := sig.Body[2].(string)
+
+			select {
+			case running <- newOwner != "":
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	var once sync.Once
+	stop := func() {
+		once.Do(func() {
+			close(done)
+			conn.RemoveSignal(signals)
+			if err := conn.RemoveMatchSignal(match...); err != nil {
+				log.Println("Error unregistering service watch:", err)
+			}
+		})
+	}
+
+	return running, stop, nil
+}
+
+// staticAdapterSource is a fixed set of adapters that never changes
+type staticAdapterSource []Adapter
+
+func (s staticAdapterSource) Adapters() ([]Adapter, error) {
+	return s, nil
+}
+
+func (s staticAdapterSource) WatchAdapters() (<-chan Adapter, <-chan string, func(), error) {
+	return nil, nil, func() {}, nil
+}
+
+func (s staticAdapterSource) WatchService() (<-chan bool, func(), error) {
+	return nil, func() {}, nil
+}
+
+// managedAdapter is an adapter together with the discovery shared by the clients scanning with it
+type managedAdapter struct {
+	Adapter
+	scanner *scanner
+}
+
+// adapterSet holds the adapters the server manages, ordered by id with the default adapter first
+type adapterSet struct {
+	defaultID string
+	// changes is published whenever adapters are added or removed
+	changes *broadcaster[struct{}]
+
+	mu        sync.Mutex
+	adapters  []*managedAdapter
+	available bool
+}
+
+func newAdapterSet(defaultID string) *adapterSet {
+	return &adapterSet{defaultID: defaultID, changes: newBroadcaster[struct{}]()}
+}
+
+// setAvailable records whether the Bluetooth service is running, the adapters are dropped when it is not
+func (s *adapterSet) setAvailable(available bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.available = available
+	if available {
+		return
+	}
+
+	for _, m := range s.adapters {
+		m.scanner.stopAll()
+	}
+	s.adapters = nil
+	s.changes.publish(struct{}{})
+}
+
+// status returns whether the Bluetooth service is running and the ids of the adapters
+func (s *adapterSet) status() (available bool, ids []string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, m := range s.adapters {
+		ids = append(ids, m.GetID())
+	}
+
+	return s.available, ids
+}
+
+// add manages the adapter, unless an adapter with the same id already is. An adapter appearing means the
+// Bluetooth service is running.
+func (s *adapterSet) add(a Adapter) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.available = true
+
+	for _, m := range s.adapters {
+		if m.GetID() == a.GetID() {
+			return false
+		}
+	}
+
+	s.adapters = append(s.adapters, &managedAdapter{Adapter: a, scanner: newScanner(a)})
+	sort.SliceStable(s.adapters, func(i, j int) bool {
+		iDefault, jDefault := s.adapters[i].GetID() == s.defaultID, s.adapters[j].GetID() == s.defaultID
+		if iDefault != jDefault {
+			return iDefault
+		}
+		return s.adapters[i].GetID() < s.adapters[j].GetID()
+	})
+	s.changes.publish(struct{}{})
+
+	return true
+}
+
+// remove stops managing the adapter and ends the discovery sessions on it
+func (s *adapterSet) remove(id string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i, m := range s.adapters {
+		if m.GetID() == id {
+			s.adapters = append(s.adapters[:i], s.adapters[i+1:]...)
+			m.scanner.stopAll()
+			s.changes.publish(struct{}{})
+			return true
+		}
+	}
+
+	return false
+}
+
+// get returns the adapter with the id, or the default adapter if id is empty. The first adapter is the
+// default while the configured default adapter is missing.
+func (s *adapterSet) get(id string) (*managedAdapter, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.available {
+		return nil, ErrBluetoothUnavailable
+	}
+
+	if id == "" {
+		if len(s.adapters) == 0 {
+			return nil, ErrAdapterNotFound
+		}
+		return s.adapters[0], nil
+	}
+
+	for _, m := range s.adapters {
+		if m.GetID() == id {
+			return m, nil
+		}
+	}
+
+	return nil, ErrAdapterNotFound
+}
+
+// list returns every adapter, or only the one with the id if it is not empty
+func (s *adapterSet) list(id string) ([]*managedAdapter, error) {
+	if id != "" {
+		m, err := s.get(id)
+		if err != nil {
+			return nil, err
+		}
+		return []*managedAdapter{m}, nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.available {
+		return nil, ErrBluetoothUnavailable
+	}
+
+	return append([]*managedAdapter(nil), s.adapters...), nil
+}
+
+// bind manages the adapters the source has now, when the server starts and whenever the Bluetooth
+// service comes back. Without the service the server keeps running degraded.
+func (s *BluetoothServer) bind() {
+	adapters, err := s.source.Adapters()
+	if err != nil {
+		log.Println("Bluetooth is unavailable, serving degraded:", err)
+		s.adapters.setAvailable(false)
+		return
+	}
+
+	s.adapters.setAvailable(true)
+	for _, a := range adapters {
+		s.adapters.add(a)
+	}
+	if len(adapters) == 0 {
+		log.Println("No Bluetooth adapter found, serving degraded")
+	}
+
+	s.updateAgent()
+}
+
+// unbind drops the adapters and the agent, which are stale once the Bluetooth service stopped
+func (s *BluetoothServer) unbind() {
+	s.adapters.setAvailable(false)
+	s.dropAgent()
+}
+
+// updateAgent registers the pairing agent while a client runs PairingAgent and drops it once none does, so
+// without one BlueZ falls back to whichever other agent is registered. Pairing works without the agent, just
+// not for devices that need a PIN or a confirmation. BlueZ has a single agent for all adapters, so it is
+// registered through the default one.
+func (s *BluetoothServer) updateAgent() {
+	s.agentMu.Lock()
+	defer s.agentMu.Unlock()
+
+	if !s.agent.attached() {
+		s.dropAgentLocked()
+		return
+	}
+	if s.unregisterAgent != nil {
+		return
+	}
+
+	a, err := s.adapters.get("")
+	if err != nil {
+		// Registered once an adapter appears
+		return
+	}
+
+	unregister, err := a.RegisterAgent(s.agent)
+	if err != nil {
+		log.Println("Pairing agent not registered:", err)
+		return
+	}
+	s.unregisterAgent = unregister
+}
+
+func (s *BluetoothServer) dropAgent() {
+	s.agentMu.Lock()
+	defer s.agentMu.Unlock()
+
+	s.dropAgentLocked()
+}
+
+func (s *BluetoothServer) dropAgentLocked() {
+	if s.unregisterAgent != nil {
+		s.unregisterAgent()
+		s.unregisterAgent = nil
+	}
+}
+
+// watchAdapters keeps the adapters of the server in sync with the source until ctx is done
+func (s *BluetoothServer) watchAdapters(ctx context.Context, running <-chan bool, added <-chan Adapter, removed <-chan string) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case r := <-running:
+			if r {
+				log.Println("Bluetooth service started, binding adapters")
+				s.bind()
+			} else {
+				log.Println("Bluetooth service stopped, serving degraded")
+				s.unbind()
+			}
+		case a := <-added:
+			if s.adapters.add(a) {
+				log.Println("Adapter added:", a.GetID())
+				s.updateAgent()
+			}
+		case id := <-removed:
+			if s.adapters.remove(id) {
+				log.Println("Adapter removed:", id)
+			}
+		}
+	}
+}
+
+// device looks up the device on the adapter with the id, or on every adapter starting with the default
+// one if id is empty, and returns it together with its adapter
+func (s *BluetoothServer) device(adapterID, address string) (Device, *managedAdapter, error) {
+	adapters, err := s.adapters.list(adapterID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, a := range adapters {
+		dev, err := a.GetDeviceByAddress(address)
+		if errors.Is(err, ErrDeviceNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return dev, a, nil
+	}
+
+	return nil, nil, ErrDeviceNotFound
+}
+
+// devices returns the devices of every adapter
+func (s *BluetoothServer) devices() ([]Device, error) {
+	adapters, err := s.adapters.list("")
+	if err != nil {
+		return nil, err
+	}
+
+	var devs []Device
+	for _, a := range adapters {
+		ds, err := a.GetDevices()
+		if err != nil {
+			return nil, err
+		}
+		devs = append(devs, ds...)
+	}
+
+	return devs, nil
+}
+
+// mergeAdapters opens a channel on every adapter and merges what they send, converted by wrap, into the returned
+// channel. It is closed once every opened channel is, which stop or the removal of all of the adapters does.
+func mergeAdapters[T, U any](adapters []*managedAdapter, open func(a *managedAdapter) (<-chan T, func(), error), wrap func(a *managedAdapter, v T) U) (<-chan U, func(), error) {
+	if len(adapters) == 0 {
+		return nil, nil, ErrAdapterNotFound
+	}
+
+	merged := make(chan U, 20)
+	done := make(chan struct{})
+	var stops []func()
+	var once sync.Once
+	stopAll := func() {
+		once.Do(func() {
+			close(done)
+			for _, stop := range stops {
+				stop()
+			}
+		})
+	}
+
+	var wg sync.WaitGroup
+	for _, a := range adapters {
+		ch, stop, err := open(a)
+		if err != nil {
+			stopAll()
+			return nil, nil, err
+		}
+		stops = append(stops, stop)
+
+		wg.Add(1)
+		go func(a *managedAdapter) {
+			defer wg.Done()
+			for v := range ch {
+				select {
+				case merged <- wrap(a, v):
+				case <-done:
+					return
+				}
+			}
+		}(a)
+	}
+
+	go func() {
+		wg.Wait()
+		close(merged)
+	}()
+
+	return merged, stopAll, nil
+}
@@ -0,0 +1,396 @@
+package bluetooth
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/godbus/dbus/v5"
+	"github.com/muka/go-bluetooth/bluez/profile/agent"
+	"github.com/muka/go-bluetooth/bluez/profile/device"
+
+	btgrpc "github.com/andree-bjorkgard/remote-bluetooth/internal/bluetooth/grpc"
+)
+
+// BlueZ gives up on an agent after a while anyway, this only bounds prompts nobody answers
+const agentPromptTimeout = 60 * time.Second
+
+const agentPath = dbus.ObjectPath("/remote_bluetooth/agent")
+
+var (
+	ErrNoAgent        = errors.New("no client is running a pairing agent")
+	ErrPromptRejected = errors.New("pairing prompt rejected")
+	ErrPromptCanceled = errors.New("pairing prompt canceled")
+)
+
+// remoteAgent forwards pairing prompts to the clients running PairingAgent. A prompt is sent to every
+// agent allowed to see the device, the first answer wins.
+type remoteAgent struct {
+	mu      sync.Mutex
+	streams map[*agentStream]struct{}
+	pending map[string]*pendingPrompt
+	nextID  int
+}
+
+var _ Agent = (*remoteAgent)(nil)
+
+type agentStream struct {
+	allowed func(dev Device) bool
+
+	mu     sync.Mutex
+	stream btgrpc.Bluetooth_PairingAgentServer
+}
+
+func (s *agentStream) send(req *btgrpc.AgentRequest) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.stream.Send(req)
+}
+
+type pendingPrompt struct {
+	recipients []*agentStream
+	answer     chan *btgrpc.AgentResponse
+	cancel     context.CancelFunc
+}
+
+func newRemoteAgent() *remoteAgent {
+	return &remoteAgent{streams: make(map[*agentStream]struct{}), pending: make(map[string]*pendingPrompt)}
+}
+
+// PairingAgent receives pairing prompts for the devices the client may pair, and the answers to them.
+func (s *BluetoothServer) PairingAgent(stream btgrpc.Bluetooth_PairingAgentServer) error {
+	ctx := stream.Context()
+	as := &agentStream{stream: stream, allowed: func(dev Device) bool { return s.allowed(ctx, "PairingAgent", dev) }}
+
+	s.agent.attach(as)
+	s.updateAgent()
+	defer func() {
+		s.agent.detach(as)
+		s.updateAgent()
+	}()
+
+	for {
+		r, err := stream.Recv()
+		if err != nil {
+			if err == io.EOF || ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+
+		s.agent.answer(as, r)
+	}
+}
+
+func (a *remoteAgent) attach(as *agentStream) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.streams[as] = struct{}{}
+}
+
+func (a *remoteAgent) detach(as *agentStream) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	delete(a.streams, as)
+}
+
+// attached reports whether any client runs PairingAgent
+func (a *remoteAgent) attached() bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return len(a.streams) > 0
+}
+
+// answer hands a response to the prompt it answers, as long as the prompt was sent to the stream
+func (a *remoteAgent) answer(as *agentStream, r *btgrpc.AgentResponse) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	p, ok := a.pending[r.Id]
+	if !ok {
+		return
+	}
+
+	for _, recipient := range p.recipients {
+		if recipient == as {
+			select {
+			case p.answer <- r:
+			default:
+			}
+			return
+		}
+	}
+}
+
+// recipients returns the streams allowed to see prompts for the device
+func (a *remoteAgent) recipients(dev Device) []*agentStream {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	var streams []*agentStream
+	for as := range a.streams {
+		if as.allowed(dev) {
+			streams = append(streams, as)
+		}
+	}
+
+	return streams
+}
+
+func (a *remoteAgent) broadcast(streams []*agentStream, req *btgrpc.AgentRequest) int {
+	sent := 0
+	for _, as := range streams {
+		if err := as.send(req); err != nil {
+			log.Println("Error sending pairing prompt:", err)
+			continue
+		}
+		sent++
+	}
+
+	return sent
+}
+
+// ask sends the prompt and waits for the first answer
+func (a *remoteAgent) ask(req *btgrpc.AgentRequest, dev Device) (*btgrpc.AgentResponse, error) {
+	streams := a.recipients(dev)
+	if len(streams) == 0 {
+		return nil, ErrNoAgent
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), agentPromptTimeout)
+	defer cancel()
+
+	p := &pendingPrompt{recipients: streams, answer: make(chan *btgrpc.AgentResponse, 1), cancel: cancel}
+	a.mu.Lock()
+	a.nextID++
+	req.Id = strconv.Itoa(a.nextID)
+	a.pending[req.Id] = p
+	a.mu.Unlock()
+
+	defer func() {
+		a.mu.Lock()
+		delete(a.pending, req.Id)
+		a.mu.Unlock()
+	}()
+
+	req.Device = deviceToGrpcDevice(dev)
+	if a.broadcast(streams, req) == 0 {
+		return nil, ErrNoAgent
+	}
+
+	select {
+	case r := <-p.answer:
+		// The other agents are still showing the prompt
+		a.broadcast(streams, &btgrpc.AgentRequest{Id: req.Id, Type: btgrpc.AgentRequest_CANCEL, Device: req.Device})
+		if !r.Accept {
+			return nil, ErrPromptRejected
+		}
+		return r, nil
+	case <-ctx.Done():
+		a.broadcast(streams, &btgrpc.AgentRequest{Id: req.Id, Type: btgrpc.AgentRequest_CANCEL, Device: req.Device})
+		return nil, ErrPromptCanceled
+	}
+}
+
+// display shows information to the agents without waiting for an answer
+func (a *remoteAgent) display(req *btgrpc.AgentRequest, dev Device) {
+	a.mu.Lock()
+	a.nextID++
+	req.Id = strconv.Itoa(a.nextID)
+	a.mu.Unlock()
+
+	req.Device = deviceToGrpcDevice(dev)
+	a.broadcast(a.recipients(dev), req)
+}
+
+func (a *remoteAgent) RequestPinCode(dev Device) (string, error) {
+	r, err := a.ask(&btgrpc.AgentRequest{Type: btgrpc.AgentRequest_REQUEST_PIN_CODE}, dev)
+	if err != nil {
+		return "", err
+	}
+
+	return r.PinCode, nil
+}
+
+func (a *remoteAgent) DisplayPinCode(dev Device, pinCode string) {
+	a.display(&btgrpc.AgentRequest{Type: btgrpc.AgentRequest_DISPLAY_PIN_CODE, PinCode: pinCode}, dev)
+}
+
+func (a *remoteAgent) RequestPasskey(dev Device) (uint32, error) {
+	r, err := a.ask(&btgrpc.AgentRequest{Type: btgrpc.AgentRequest_REQUEST_PASSKEY}, dev)
+	if err != nil {
+		return 0, err
+	}
+
+	return r.Passkey, nil
+}
+
+func (a *remoteAgent) DisplayPasskey(dev Device, passkey uint32, entered uint16) {
+	a.display(&btgrpc.AgentRequest{Type: btgrpc.AgentRequest_DISPLAY_PASSKEY, Passkey: passkey, Entered: uint32(entered)}, dev)
+}
+
+func (a *remoteAgent) RequestConfirmation(dev Device, passkey uint32) error {
+	_, err := a.ask(&btgrpc.AgentRequest{Type: btgrpc.AgentRequest_REQUEST_CONFIRMATION, Passkey: passkey}, dev)
+	return err
+}
+
+func (a *remoteAgent) RequestAuthorization(dev Device) error {
+	_, err := a.ask(&btgrpc.AgentRequest{Type: btgrpc.AgentRequest_REQUEST_AUTHORIZATION}, dev)
+	return err
+}
+
+func (a *remoteAgent) AuthorizeService(dev Device, uuid string) error {
+	_, err := a.ask(&btgrpc.AgentRequest{Type: btgrpc.AgentRequest_AUTHORIZE_SERVICE, Uuid: uuid}, dev)
+	return err
+}
+
+func (a *remoteAgent) Cancel() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	for _, p := range a.pending {
+		p.cancel()
+	}
+}
+
+// RegisterAgent exports an org.bluez.Agent1 forwarding to ag and makes it the default agent until
+// unregister is called, so pairing started by the device is handled as well.
+func (a *bluezAdapter) RegisterAgent(ag Agent) (func(), error) {
+	conn, err := dbus.SystemBus()
+	if err != nil {
+		return nil, err
+	}
+
+	b := &bluezAgent{agent: ag}
+	if err := agent.ExposeAgent(conn, b, agent.CapKeyboardDisplay, true); err != nil {
+		return nil, err
+	}
+
+	return func() {
+		if err := agent.RemoveAgent(b); err != nil {
+			log.Println("Error unregistering agent:", err)
+		}
+		if err := conn.Export(nil, b.Path(), b.Interface()); err != nil {
+			log.Println("Error unexporting agent:", err)
+		}
+	}, nil
+}
+
+// bluezAgent implements org.bluez.Agent1 on top of an Agent
+type bluezAgent struct {
+	agent Agent
+}
+
+var _ agent.Agent1Client = (*bluezAgent)(nil)
+
+func (b *bluezAgent) Path() dbus.ObjectPath {
+	return agentPath
+}
+
+func (b *bluezAgent) Interface() string {
+	return agent.Agent1Interface
+}
+
+func (b *bluezAgent) Release() *dbus.Error {
+	return nil
+}
+
+func (b *bluezAgent) RequestPinCode(path dbus.ObjectPath) (string, *dbus.Error) {
+	dev, dErr := agentDevice(path)
+	if dErr != nil {
+		return "", dErr
+	}
+
+	pin, err := b.agent.RequestPinCode(dev)
+	return pin, agentError(err)
+}
+
+func (b *bluezAgent) DisplayPinCode(path dbus.ObjectPath, pinCode string) *dbus.Error {
+	dev, dErr := agentDevice(path)
+	if dErr != nil {
+		return dErr
+	}
+
+	b.agent.DisplayPinCode(dev, pinCode)
+	return nil
+}
+
+func (b *bluezAgent) RequestPasskey(path dbus.ObjectPath) (uint32, *dbus.Error) {
+	dev, dErr := agentDevice(path)
+	if dErr != nil {
+		return 0, dErr
+	}
+
+	passkey, err := b.agent.RequestPasskey(dev)
+	return passkey, agentError(err)
+}
+
+func (b *bluezAgent) DisplayPasskey(path dbus.ObjectPath, passkey uint32, entered uint16) *dbus.Error {
+	dev, dErr := agentDevice(path)
+	if dErr != nil {
+		return dErr
+	}
+
+	b.agent.DisplayPasskey(dev, passkey, entered)
+	return nil
+}
+
+func (b *bluezAgent) RequestConfirmation(path dbus.ObjectPath, passkey uint32) *dbus.Error {
+	dev, dErr := agentDevice(path)
+	if dErr != nil {
+		return dErr
+	}
+
+	return agentError(b.agent.RequestConfirmation(dev, passkey))
+}
+
+func (b *bluezAgent) RequestAuthorization(path dbus.ObjectPath) *dbus.Error {
+	dev, dErr := agentDevice(path)
+	if dErr != nil {
+		return dErr
+	}
+
+	return agentError(b.agent.RequestAuthorization(dev))
+}
+
+func (b *bluezAgent) AuthorizeService(path dbus.ObjectPath, uuid string) *dbus.Error {
+	dev, dErr := agentDevice(path)
+	if dErr != nil {
+		return dErr
+	}
+
+	return agentError(b.agent.AuthorizeService(dev, uuid))
+}
+
+func (b *bluezAgent) Cancel() *dbus.Error {
+	b.agent.Cancel()
+	return nil
+}
+
+func agentDevice(path dbus.ObjectPath) (Device, *dbus.Error) {
+	d, err := device.NewDevice1(path)
+	if err != nil {
+		return nil, dbus.MakeFailedError(err)
+	}
+
+	return &bluezDevice{d}, nil
+}
+
+func agentError(err error) *dbus.Error {
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, ErrPromptCanceled):
+		return dbus.NewError("org.bluez.Error.Canceled", []interface{}{err.Error()})
+	default:
+		return dbus.NewError("org.bluez.Error.Rejected", []interface{}{err.Error()})
+	}
+}
@@ -0,0 +1,134 @@
+package bluetooth
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"path"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/andree-bjorkgard/remote-bluetooth/internal/acl"
+	"github.com/andree-bjorkgard/remote-bluetooth/internal/pairing"
+	"github.com/andree-bjorkgard/remote-bluetooth/pkg/config"
+)
+
+// Methods needed to pair a client, which by definition has no token yet, and the health check
+var unauthenticatedMethods = map[string]bool{
+	"/grpc.Bluetooth/GetServerInfo":  true,
+	"/grpc.Bluetooth/PairClient":     true,
+	"/grpc.Bluetooth/ConfirmPairing": true,
+	"/grpc.Bluetooth/GetHealth":      true,
+}
+
+type clientIdentityKey struct{}
+
+func unaryServerInterceptor(cfg config.Config, registry *pairing.Registry, rules *acl.ACL) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if unauthenticatedMethods[info.FullMethod] {
+			return handler(ctx, req)
+		}
+
+		identity, ok := authorize(ctx, cfg.AuthenticationSecret, registry)
+		if !ok {
+			return nil, unauthenticated()
+		}
+		if !rules.AllowedOperation(identity, path.Base(info.FullMethod)) {
+			return nil, operationDenied(path.Base(info.FullMethod), identity)
+		}
+
+		return handler(context.WithValue(ctx, clientIdentityKey{}, identity), req)
+	}
+}
+
+func streamServerInterceptor(cfg config.Config, registry *pairing.Registry, rules *acl.ACL) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		identity, ok := authorize(ss.Context(), cfg.AuthenticationSecret, registry)
+		if !ok {
+			return unauthenticated()
+		}
+		if !rules.AllowedOperation(identity, path.Base(info.FullMethod)) {
+			return operationDenied(path.Base(info.FullMethod), identity)
+		}
+
+		return handler(srv, &identifiedStream{ServerStream: ss, ctx: context.WithValue(ss.Context(), clientIdentityKey{}, identity)})
+	}
+}
+
+type identifiedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *identifiedStream) Context() context.Context {
+	return s.ctx
+}
+
+// authorize accepts a token issued to a paired client, or the shared secret if one is configured.
+// The identity is the name of the paired client, or the subject of the TLS client certificate when
+// the shared secret is used.
+func authorize(ctx context.Context, secret string, registry *pairing.Registry) (identity string, ok bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		md = metadata.New(nil)
+	}
+	auth := md.Get("Authorization")
+	if len(auth) != 1 {
+		return "", false
+	}
+
+	if name, ok := registry.Authorize(auth[0]); ok {
+		return name, true
+	}
+
+	if secret != "" && subtle.ConstantTimeCompare([]byte(auth[0]), []byte(secret)) == 1 {
+		return certificateSubject(ctx), true
+	}
+
+	return "", false
+}
+
+func certificateSubject(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.VerifiedChains) == 0 || len(tlsInfo.State.VerifiedChains[0]) == 0 {
+		return ""
+	}
+
+	return tlsInfo.State.VerifiedChains[0][0].Subject.CommonName
+}
+
+func clientIdentity(ctx context.Context) string {
+	identity, _ := ctx.Value(clientIdentityKey{}).(string)
+	return identity
+}
+
+// allowed checks the ACL for the device, operation is the name of the RPC
+func (s *BluetoothServer) allowed(ctx context.Context, operation string, dev Device) bool {
+	addr, _ := dev.GetAddress()
+	name, _ := dev.GetName()
+
+	return s.acl.Allowed(clientIdentity(ctx), operation, addr, name)
+}
+
+func unauthenticated() error {
+	return newStatus(codes.Unauthenticated, ReasonUnauthenticated, nil, "invalid secret")
+}
+
+func operationDenied(operation, identity string) error {
+	return newStatus(codes.PermissionDenied, ReasonPermissionDenied, map[string]string{"operation": operation},
+		fmt.Sprintf("%s is not allowed for %q", operation, identity))
+}
+
+func permissionDenied(ctx context.Context, operation, address string) error {
+	return newStatus(codes.PermissionDenied, ReasonPermissionDenied, map[string]string{"operation": operation, "address": address},
+		fmt.Sprintf("%s on %s is not allowed for %q", operation, address, clientIdentity(ctx)))
+}
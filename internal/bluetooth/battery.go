@@ -0,0 +1,153 @@
+package bluetooth
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/andree-bjorkgard/remote-bluetooth/internal/battery"
+	btgrpc "github.com/andree-bjorkgard/remote-bluetooth/internal/bluetooth/grpc"
+)
+
+type batteryAlert struct {
+	dev        Device
+	threshold  byte
+	percentage byte
+}
+
+// watchBatteries checks the battery level of a device against the alert thresholds whenever the device changes,
+// until ctx is done. With a positive interval the level of every connected device is also recorded each interval,
+// and on every change.
+func (s *BluetoothServer) watchBatteries(ctx context.Context, interval time.Duration) {
+	sampling := interval > 0
+	var tick <-chan time.Time
+	if sampling {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+
+	changed := s.followDevices(ctx)
+
+	if sampling {
+		s.sampleBatteries(time.Now())
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case d := <-changed:
+			s.checkBattery(d, sampling, time.Now())
+		case <-tick:
+			s.sampleBatteries(time.Now())
+		}
+	}
+}
+
+func (s *BluetoothServer) sampleBatteries(now time.Time) {
+	devs, err := s.devices()
+	if err != nil {
+		// Being degraded is logged once already
+		if !errors.Is(err, ErrBluetoothUnavailable) {
+			log.Println("Error sampling batteries:", err)
+		}
+		return
+	}
+
+	for _, d := range devs {
+		s.checkBattery(d, true, now)
+	}
+}
+
+// checkBattery alerts if the battery of the device dropped to a threshold, and records the level if record is set
+func (s *BluetoothServer) checkBattery(dev Device, record bool, now time.Time) {
+	p, _, ok := s.batteryLevel(dev)
+	if !ok {
+		return
+	}
+
+	addr, _ := dev.GetAddress()
+	if record {
+		s.batteries.Record(addr, p, now)
+	}
+
+	if threshold, ok := s.thresholds.Check(addr, p); ok {
+		s.alerts.publish(batteryAlert{dev: dev, threshold: threshold, percentage: p})
+	}
+}
+
+// WatchBatteryAlerts streams an alert whenever the battery of a device drops to one of the thresholds
+func (s *BluetoothServer) WatchBatteryAlerts(_ *btgrpc.Empty, stream btgrpc.Bluetooth_WatchBatteryAlertsServer) error {
+	alerts, unsubscribe := s.alerts.subscribe()
+	defer unsubscribe()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case a := <-alerts:
+			if !s.allowed(stream.Context(), "WatchBatteryAlerts", a.dev) {
+				continue
+			}
+
+			alert := &btgrpc.BatteryAlert{
+				Device:     s.grpcDevice(a.dev),
+				Threshold:  uint32(a.threshold),
+				Percentage: uint32(a.percentage),
+			}
+			addr, _ := a.dev.GetAddress()
+			if d, ok := battery.TimeToEmpty(s.batteries.Samples(addr)); ok {
+				seconds := int64(d.Seconds())
+				alert.TimeToEmpty = &seconds
+			}
+
+			if err := stream.Send(alert); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// GetBatteryHistory returns the recorded battery levels of a device, or of every device if no address is given
+func (s *BluetoothServer) GetBatteryHistory(ctx context.Context, request *btgrpc.BatteryHistoryRequest) (*btgrpc.BatteryHistories, error) {
+	addrs := []string{request.Address}
+	if request.Address == "" {
+		addrs = s.batteries.Addresses()
+	}
+
+	resp := &btgrpc.BatteryHistories{}
+	for _, addr := range addrs {
+		// Devices BlueZ has forgotten keep their history, the ACL is then checked by address only
+		var name string
+		if dev, _, err := s.device("", addr); err == nil {
+			name, _ = dev.GetName()
+		} else if request.Address != "" && len(s.batteries.Samples(addr)) == 0 {
+			return nil, deviceError(err, addr)
+		}
+		if !s.acl.Allowed(clientIdentity(ctx), "GetBatteryHistory", addr, name) {
+			if request.Address != "" {
+				return nil, permissionDenied(ctx, "GetBatteryHistory", addr)
+			}
+			continue
+		}
+
+		resp.Histories = append(resp.Histories, batteryHistoryToGrpc(addr, s.batteries.Samples(addr)))
+	}
+
+	return resp, nil
+}
+
+func batteryHistoryToGrpc(address string, samples []battery.Sample) *btgrpc.BatteryHistory {
+	h := &btgrpc.BatteryHistory{Address: address}
+	for _, sample := range samples {
+		h.Samples = append(h.Samples, &btgrpc.BatterySample{Timestamp: sample.Time.Unix(), Percentage: uint32(sample.Percentage)})
+	}
+
+	if d, ok := battery.TimeToEmpty(samples); ok {
+		seconds := int64(d.Seconds())
+		h.TimeToEmpty = &seconds
+	}
+
+	return h
+}
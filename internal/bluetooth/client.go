@@ -3,46 +3,94 @@ package bluetooth
 import (
 	"context"
 	"errors"
+	"io"
+	"log"
+	"sync"
+	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 
 	btgrpc "github.com/andree-bjorkgard/remote-bluetooth/internal/bluetooth/grpc"
+	"github.com/andree-bjorkgard/remote-bluetooth/internal/certs"
+	"github.com/andree-bjorkgard/remote-bluetooth/internal/pairing"
+	"github.com/andree-bjorkgard/remote-bluetooth/pkg/config"
 )
 
+const disconnectPollInterval = 500 * time.Millisecond
+
 var (
-	ErrConnectingFailed    = errors.New("connecting to device failed")
-	ErrDisconnectingFailed = errors.New("disconnecting to device failed")
+	ErrConnectingFailed       = errors.New("connecting to device failed")
+	ErrDisconnectingFailed    = errors.New("disconnecting to device failed")
+	ErrDisconnectNotConfirmed = errors.New("device did not report disconnected in time")
+	ErrPairingFailed          = errors.New("pairing with device failed")
+	ErrRequestFailed          = errors.New("request failed")
 )
 
 type BluetoothClient struct {
 	client btgrpc.BluetoothClient
 	conn   *grpc.ClientConn
+	tokens *pairing.TokenStore
 
+	mu            sync.Mutex
 	authorization string
 }
 
-func NewBluetoothClient(addr string, authorization string) (*BluetoothClient, error) {
+// NewBluetoothClient dials the server at addr. Extra dial options, e.g. a custom dialer, are appended to the defaults.
+func NewBluetoothClient(addr string, cfg config.Config, extraOpts ...grpc.DialOption) (*BluetoothClient, error) {
+	tlsCfg, err := certs.ClientConfig(cfg.TLSCertFile, cfg.TLSKeyFile, cfg.TLSCAFile, cfg.TLSServerName)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens, err := pairing.LoadTokenStore(cfg.TokensFile)
+	if err != nil {
+		return nil, err
+	}
+
+	creds := insecure.NewCredentials()
+	if tlsCfg != nil {
+		creds = credentials.NewTLS(tlsCfg)
+	}
+
+	c := &BluetoothClient{tokens: tokens, authorization: cfg.AuthenticationSecret}
 	opts := []grpc.DialOption{
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
-		grpc.WithUnaryInterceptor(unaryClientInterceptor(authorization)),
+		grpc.WithTransportCredentials(creds),
+		grpc.WithChainUnaryInterceptor(
+			timeoutClientInterceptor(cfg.RequestTimeout, cfg.ConnectTimeout),
+			unaryClientInterceptor(c.getAuthorization),
+		),
+		grpc.WithStreamInterceptor(streamClientInterceptor(c.getAuthorization)),
 	}
-	conn, err := grpc.Dial(addr, opts...)
+	conn, err := grpc.Dial(addr, append(opts, extraOpts...)...)
 	if err != nil {
 		return nil, err
 	}
-	client := btgrpc.NewBluetoothClient(conn)
+	c.conn = conn
+	c.client = btgrpc.NewBluetoothClient(conn)
 
-	return &BluetoothClient{client: client, conn: conn, authorization: authorization}, nil
+	return c, nil
 }
 
 func (c *BluetoothClient) Close() error {
 	return c.conn.Close()
 }
 
-func (c *BluetoothClient) GetTrustedDevices() ([]*btgrpc.Device, error) {
-	devs, err := c.client.GetTrustedDevices(context.Background(), &btgrpc.Empty{})
+func (c *BluetoothClient) GetTrustedDevices(ctx context.Context) ([]*btgrpc.Device, error) {
+	devs, err := c.client.GetTrustedDevices(ctx, &btgrpc.Empty{})
+	if err != nil {
+		return nil, err
+	}
+
+	return devs.Devices, nil
+}
+
+func (c *BluetoothClient) ListDevices(ctx context.Context, request *btgrpc.ListDevicesRequest) ([]*btgrpc.Device, error) {
+	devs, err := c.client.ListDevices(ctx, request)
 	if err != nil {
 		return nil, err
 	}
@@ -50,8 +98,71 @@ func (c *BluetoothClient) GetTrustedDevices() ([]*btgrpc.Device, error) {
 	return devs.Devices, nil
 }
 
-func (c *BluetoothClient) ConnectToDevice(mac string) error {
-	r, err := c.client.ConnectToDevice(context.Background(), &btgrpc.ConnectRequest{Address: mac})
+// GetBatteryHistory returns the battery levels the server recorded for the device, or for every device if mac is empty
+func (c *BluetoothClient) GetBatteryHistory(ctx context.Context, mac string) ([]*btgrpc.BatteryHistory, error) {
+	h, err := c.client.GetBatteryHistory(ctx, &btgrpc.BatteryHistoryRequest{Address: mac})
+	if err != nil {
+		return nil, err
+	}
+
+	return h.Histories, nil
+}
+
+// RequestOption changes a device or adapter request
+type RequestOption func(o *requestOptions)
+
+type requestOptions struct {
+	adapterID   string
+	powerOn     bool
+	transportID string
+	// withoutResponse writes characteristics without waiting for the device to confirm
+	withoutResponse bool
+}
+
+func newRequestOptions(opts []RequestOption) requestOptions {
+	var o requestOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	return o
+}
+
+// OnAdapter makes the request use the adapter with the id, e.g. hci1, instead of the default adapter or
+// the first adapter that knows the device
+func OnAdapter(id string) RequestOption {
+	return func(o *requestOptions) {
+		o.adapterID = id
+	}
+}
+
+// WithPowerOn makes ConnectToDevice power on the adapter if it is off, instead of failing with
+// ErrAdapterNotReady. Other requests ignore it.
+func WithPowerOn() RequestOption {
+	return func(o *requestOptions) {
+		o.powerOn = true
+	}
+}
+
+// OnTransport makes GetVolume and SetVolume use the media transport with the id, e.g. sep1/fd0, instead
+// of the first transport of the device with a volume. Other requests ignore it.
+func OnTransport(id string) RequestOption {
+	return func(o *requestOptions) {
+		o.transportID = id
+	}
+}
+
+// WithoutResponse makes WriteCharacteristic write without waiting for the device to confirm the write.
+// Other requests ignore it.
+func WithoutResponse() RequestOption {
+	return func(o *requestOptions) {
+		o.withoutResponse = true
+	}
+}
+
+func (c *BluetoothClient) ConnectToDevice(ctx context.Context, mac string, opts ...RequestOption) error {
+	o := newRequestOptions(opts)
+	r, err := c.client.ConnectToDevice(ctx, &btgrpc.ConnectRequest{Address: mac, AdapterId: o.adapterID, PowerOn: o.powerOn})
 	if err != nil {
 		return err
 	}
@@ -63,8 +174,9 @@ func (c *BluetoothClient) ConnectToDevice(mac string) error {
 	return nil
 }
 
-func (c *BluetoothClient) DisconnectFromDevice(mac string) error {
-	r, err := c.client.DisconnectFromDevice(context.Background(), &btgrpc.DisconnectRequest{Address: mac})
+func (c *BluetoothClient) DisconnectFromDevice(ctx context.Context, mac string, opts ...RequestOption) error {
+	o := newRequestOptions(opts)
+	r, err := c.client.DisconnectFromDevice(ctx, &btgrpc.DisconnectRequest{Address: mac, AdapterId: o.adapterID})
 	if err != nil {
 		return err
 	}
@@ -76,15 +188,557 @@ func (c *BluetoothClient) DisconnectFromDevice(mac string) error {
 	return nil
 }
 
-func unaryClientInterceptor(secret string) grpc.UnaryClientInterceptor {
+// ConnectProfile connects the profile given as a UUID, a name like "Audio Sink" or a short name like "a2dp"
+func (c *BluetoothClient) ConnectProfile(ctx context.Context, mac, profile string, opts ...RequestOption) error {
+	o := newRequestOptions(opts)
+	r, err := c.client.ConnectProfile(ctx, &btgrpc.ProfileRequest{Address: mac, Profile: profile, AdapterId: o.adapterID})
+	return checkResponse(r, err, ErrConnectingFailed)
+}
+
+func (c *BluetoothClient) DisconnectProfile(ctx context.Context, mac, profile string, opts ...RequestOption) error {
+	o := newRequestOptions(opts)
+	r, err := c.client.DisconnectProfile(ctx, &btgrpc.ProfileRequest{Address: mac, Profile: profile, AdapterId: o.adapterID})
+	return checkResponse(r, err, ErrDisconnectingFailed)
+}
+
+// GetDevice looks up a single device by its address
+func (c *BluetoothClient) GetDevice(ctx context.Context, mac string, opts ...RequestOption) (*btgrpc.Device, error) {
+	o := newRequestOptions(opts)
+	return c.client.GetDevice(ctx, &btgrpc.DeviceRequest{Address: mac, AdapterId: o.adapterID})
+}
+
+// IsConnected reports whether the server is connected to the device, a device the server does not know is not connected
+func (c *BluetoothClient) IsConnected(ctx context.Context, mac string) (bool, error) {
+	d, err := c.GetDevice(ctx, mac)
+	if status.Code(err) == codes.Unimplemented {
+		// Servers without GetDevice only expose the trusted devices
+		return c.isTrustedConnected(ctx, mac)
+	}
+	if errors.Is(err, ErrDeviceNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return d.Connected, nil
+}
+
+func (c *BluetoothClient) isTrustedConnected(ctx context.Context, mac string) (bool, error) {
+	ds, err := c.GetTrustedDevices(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	for _, d := range ds {
+		if d.Address == mac {
+			return d.Connected, nil
+		}
+	}
+
+	return false, nil
+}
+
+// WaitForDisconnect polls the server until the device is no longer connected, or fails once ctx is done.
+func (c *BluetoothClient) WaitForDisconnect(ctx context.Context, mac string) error {
+	ticker := time.NewTicker(disconnectPollInterval)
+	defer ticker.Stop()
+
+	for {
+		connected, err := c.IsConnected(ctx, mac)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ErrDisconnectNotConfirmed
+			}
+			return err
+		}
+		if !connected {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ErrDisconnectNotConfirmed
+		case <-ticker.C:
+		}
+	}
+}
+
+// recvLoop sends everything received on the stream to the returned channel, which is closed when the stream
+// ends or ctx is done. Errors other than the stream ending are logged as receiving what.
+func recvLoop[T any](ctx context.Context, stream interface{ Recv() (T, error) }, size int, what string) <-chan T {
+	ch := make(chan T, size)
+	go func() {
+		defer close(ch)
+		for {
+			v, err := stream.Recv()
+			if err != nil {
+				if err != io.EOF && ctx.Err() == nil {
+					log.Println("Error receiving "+what+": ", clientError(err))
+				}
+				return
+			}
+
+			select {
+			case ch <- v:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch
+}
+
+// WatchDevices streams a device every time one of its properties changes on the server.
+// The returned channel is closed when the stream ends, which cancelling ctx does.
+func (c *BluetoothClient) WatchDevices(ctx context.Context) (<-chan *btgrpc.Device, error) {
+	stream, err := c.client.WatchDevices(ctx, &btgrpc.Empty{})
+	if err != nil {
+		return nil, err
+	}
+
+	return recvLoop[*btgrpc.Device](ctx, stream, 10, "device update"), nil
+}
+
+// WatchBatteryAlerts streams an alert whenever the battery of a device on the server runs low.
+// The returned channel is closed when the stream ends, which cancelling ctx does.
+func (c *BluetoothClient) WatchBatteryAlerts(ctx context.Context) (<-chan *btgrpc.BatteryAlert, error) {
+	stream, err := c.client.WatchBatteryAlerts(ctx, &btgrpc.Empty{})
+	if err != nil {
+		return nil, err
+	}
+
+	return recvLoop[*btgrpc.BatteryAlert](ctx, stream, 10, "battery alert"), nil
+}
+
+// StartDiscovery makes the server scan for devices, with every adapter unless OnAdapter is given, and
+// streams every device it sees. The returned channel is closed when the scan ends, which cancelling ctx
+// or calling StopDiscovery does.
+func (c *BluetoothClient) StartDiscovery(ctx context.Context, opts ...RequestOption) (<-chan *btgrpc.DiscoveredDevice, error) {
+	o := newRequestOptions(opts)
+	stream, err := c.client.StartDiscovery(ctx, &btgrpc.AdapterRequest{AdapterId: o.adapterID})
+	if err != nil {
+		return nil, err
+	}
+
+	return recvLoop[*btgrpc.DiscoveredDevice](ctx, stream, 10, "discovered device"), nil
+}
+
+// ObserveAdvertisements makes the server observe the LE advertisements that pass the filter, with every
+// adapter unless OnAdapter is given. The returned channel is closed when ctx is done.
+func (c *BluetoothClient) ObserveAdvertisements(ctx context.Context, filter ObserveFilter, opts ...RequestOption) (<-chan *btgrpc.Advertisement, error) {
+	o := newRequestOptions(opts)
+	stream, err := c.client.ObserveAdvertisements(ctx, &btgrpc.ObserveRequest{
+		AdapterId: o.adapterID,
+		Uuids:     filter.UUIDs,
+		Rssi:      int32(filter.RSSI),
+		Pattern:   filter.Pattern,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return recvLoop[*btgrpc.Advertisement](ctx, stream, 20, "advertisement"), nil
+}
+
+// StopDiscovery stops the scan on the server, for every client that started one
+func (c *BluetoothClient) StopDiscovery(ctx context.Context, opts ...RequestOption) error {
+	o := newRequestOptions(opts)
+	r, err := c.client.StopDiscovery(ctx, &btgrpc.AdapterRequest{AdapterId: o.adapterID})
+	return checkResponse(r, err, ErrRequestFailed)
+}
+
+func (c *BluetoothClient) PairDevice(ctx context.Context, mac string, opts ...RequestOption) error {
+	o := newRequestOptions(opts)
+	r, err := c.client.PairDevice(ctx, &btgrpc.DeviceRequest{Address: mac, AdapterId: o.adapterID})
+	return checkResponse(r, err, ErrPairingFailed)
+}
+
+func (c *BluetoothClient) TrustDevice(ctx context.Context, mac string, opts ...RequestOption) error {
+	o := newRequestOptions(opts)
+	r, err := c.client.TrustDevice(ctx, &btgrpc.DeviceRequest{Address: mac, AdapterId: o.adapterID})
+	return checkResponse(r, err, ErrRequestFailed)
+}
+
+func (c *BluetoothClient) UntrustDevice(ctx context.Context, mac string, opts ...RequestOption) error {
+	o := newRequestOptions(opts)
+	r, err := c.client.UntrustDevice(ctx, &btgrpc.DeviceRequest{Address: mac, AdapterId: o.adapterID})
+	return checkResponse(r, err, ErrRequestFailed)
+}
+
+func (c *BluetoothClient) RemoveDevice(ctx context.Context, mac string, opts ...RequestOption) error {
+	o := newRequestOptions(opts)
+	r, err := c.client.RemoveDevice(ctx, &btgrpc.DeviceRequest{Address: mac, AdapterId: o.adapterID})
+	return checkResponse(r, err, ErrRequestFailed)
+}
+
+func (c *BluetoothClient) MediaPlay(ctx context.Context, mac string, opts ...RequestOption) error {
+	return c.onPlayer(ctx, c.client.MediaPlay, mac, opts)
+}
+
+func (c *BluetoothClient) MediaPause(ctx context.Context, mac string, opts ...RequestOption) error {
+	return c.onPlayer(ctx, c.client.MediaPause, mac, opts)
+}
+
+func (c *BluetoothClient) MediaNext(ctx context.Context, mac string, opts ...RequestOption) error {
+	return c.onPlayer(ctx, c.client.MediaNext, mac, opts)
+}
+
+func (c *BluetoothClient) MediaPrevious(ctx context.Context, mac string, opts ...RequestOption) error {
+	return c.onPlayer(ctx, c.client.MediaPrevious, mac, opts)
+}
+
+func (c *BluetoothClient) MediaVolumeUp(ctx context.Context, mac string, opts ...RequestOption) error {
+	return c.onPlayer(ctx, c.client.MediaVolumeUp, mac, opts)
+}
+
+func (c *BluetoothClient) MediaVolumeDown(ctx context.Context, mac string, opts ...RequestOption) error {
+	return c.onPlayer(ctx, c.client.MediaVolumeDown, mac, opts)
+}
+
+func (c *BluetoothClient) onPlayer(ctx context.Context, method func(context.Context, *btgrpc.DeviceRequest, ...grpc.CallOption) (*btgrpc.Response, error), mac string, opts []RequestOption) error {
+	o := newRequestOptions(opts)
+	r, err := method(ctx, &btgrpc.DeviceRequest{Address: mac, AdapterId: o.adapterID})
+	return checkResponse(r, err, ErrRequestFailed)
+}
+
+// GetNowPlaying returns what the media player of the device is playing
+func (c *BluetoothClient) GetNowPlaying(ctx context.Context, mac string, opts ...RequestOption) (*btgrpc.NowPlaying, error) {
+	o := newRequestOptions(opts)
+	return c.client.GetNowPlaying(ctx, &btgrpc.DeviceRequest{Address: mac, AdapterId: o.adapterID})
+}
+
+// WatchNowPlaying streams what the media player of the device is playing, first right away and then
+// every time it changes. The returned channel is closed when the device has no player left or ctx is done.
+func (c *BluetoothClient) WatchNowPlaying(ctx context.Context, mac string, opts ...RequestOption) (<-chan *btgrpc.NowPlaying, error) {
+	o := newRequestOptions(opts)
+	stream, err := c.client.WatchNowPlaying(ctx, &btgrpc.DeviceRequest{Address: mac, AdapterId: o.adapterID})
+	if err != nil {
+		return nil, err
+	}
+
+	return recvLoop[*btgrpc.NowPlaying](ctx, stream, 10, "now playing"), nil
+}
+
+// ListMediaTransports returns the audio streams of the device, one for every connected audio profile
+func (c *BluetoothClient) ListMediaTransports(ctx context.Context, mac string, opts ...RequestOption) ([]*btgrpc.MediaTransport, error) {
+	o := newRequestOptions(opts)
+	r, err := c.client.ListMediaTransports(ctx, &btgrpc.DeviceRequest{Address: mac, AdapterId: o.adapterID})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Transports, nil
+}
+
+func (c *BluetoothClient) GetVolume(ctx context.Context, mac string, opts ...RequestOption) (*btgrpc.MediaTransport, error) {
+	o := newRequestOptions(opts)
+	return c.client.GetVolume(ctx, &btgrpc.TransportRequest{Address: mac, TransportId: o.transportID, AdapterId: o.adapterID})
+}
+
+// SetVolume sets the absolute volume of the device, between 0 and 127
+func (c *BluetoothClient) SetVolume(ctx context.Context, mac string, volume uint16, opts ...RequestOption) (*btgrpc.MediaTransport, error) {
+	o := newRequestOptions(opts)
+	return c.client.SetVolume(ctx, &btgrpc.SetVolumeRequest{Address: mac, TransportId: o.transportID, Volume: uint32(volume), AdapterId: o.adapterID})
+}
+
+// WatchVolume streams every transport of the device and then every transport whose volume changes.
+// The returned channel is closed when the device has no transport left or ctx is done.
+func (c *BluetoothClient) WatchVolume(ctx context.Context, mac string, opts ...RequestOption) (<-chan *btgrpc.MediaTransport, error) {
+	o := newRequestOptions(opts)
+	stream, err := c.client.WatchVolume(ctx, &btgrpc.DeviceRequest{Address: mac, AdapterId: o.adapterID})
+	if err != nil {
+		return nil, err
+	}
+
+	return recvLoop[*btgrpc.MediaTransport](ctx, stream, 10, "volume"), nil
+}
+
+// ListGattServices returns the GATT services of the connected device with their characteristics
+func (c *BluetoothClient) ListGattServices(ctx context.Context, mac string, opts ...RequestOption) ([]*btgrpc.GattService, error) {
+	o := newRequestOptions(opts)
+	r, err := c.client.ListGattServices(ctx, &btgrpc.DeviceRequest{Address: mac, AdapterId: o.adapterID})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Services, nil
+}
+
+// ReadCharacteristic reads the characteristic with the id, e.g. service000a/char000b, or the UUID, e.g. 2a19,
+// if no other characteristic of the device has it
+func (c *BluetoothClient) ReadCharacteristic(ctx context.Context, mac, characteristic string, opts ...RequestOption) ([]byte, error) {
+	o := newRequestOptions(opts)
+	r, err := c.client.ReadCharacteristic(ctx, &btgrpc.CharacteristicRequest{Address: mac, Characteristic: characteristic, AdapterId: o.adapterID})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Value, nil
+}
+
+func (c *BluetoothClient) WriteCharacteristic(ctx context.Context, mac, characteristic string, value []byte, opts ...RequestOption) error {
+	o := newRequestOptions(opts)
+	r, err := c.client.WriteCharacteristic(ctx, &btgrpc.WriteCharacteristicRequest{
+		Address:         mac,
+		Characteristic:  characteristic,
+		Value:           value,
+		WithoutResponse: o.withoutResponse,
+		AdapterId:       o.adapterID,
+	})
+	return checkResponse(r, err, ErrRequestFailed)
+}
+
+// WatchCharacteristic streams every value the device notifies for the characteristic. The returned
+// channel is closed when the device disconnects or ctx is done.
+func (c *BluetoothClient) WatchCharacteristic(ctx context.Context, mac, characteristic string, opts ...RequestOption) (<-chan *btgrpc.CharacteristicValue, error) {
+	o := newRequestOptions(opts)
+	stream, err := c.client.WatchCharacteristic(ctx, &btgrpc.CharacteristicRequest{Address: mac, Characteristic: characteristic, AdapterId: o.adapterID})
+	if err != nil {
+		return nil, err
+	}
+
+	return recvLoop[*btgrpc.CharacteristicValue](ctx, stream, 10, "characteristic value"), nil
+}
+
+// ListAdapters returns every adapter of the server, the default adapter first
+func (c *BluetoothClient) ListAdapters(ctx context.Context) ([]*btgrpc.Adapter, error) {
+	as, err := c.client.ListAdapters(ctx, &btgrpc.Empty{})
+	if err != nil {
+		return nil, err
+	}
+
+	return as.Adapters, nil
+}
+
+func (c *BluetoothClient) GetAdapter(ctx context.Context, opts ...RequestOption) (*btgrpc.Adapter, error) {
+	o := newRequestOptions(opts)
+	return c.client.GetAdapter(ctx, &btgrpc.AdapterRequest{AdapterId: o.adapterID})
+}
+
+func (c *BluetoothClient) SetPowered(ctx context.Context, powered bool, opts ...RequestOption) (*btgrpc.Adapter, error) {
+	o := newRequestOptions(opts)
+	return c.client.SetPowered(ctx, &btgrpc.SetPoweredRequest{Powered: powered, AdapterId: o.adapterID})
+}
+
+// SetDiscoverable makes the adapter visible to other devices, until the timeout passes unless it is 0
+func (c *BluetoothClient) SetDiscoverable(ctx context.Context, discoverable bool, timeout time.Duration, opts ...RequestOption) (*btgrpc.Adapter, error) {
+	o := newRequestOptions(opts)
+	return c.client.SetDiscoverable(ctx, &btgrpc.SetDiscoverableRequest{
+		Discoverable: discoverable,
+		Timeout:      uint32(timeout.Seconds()),
+		AdapterId:    o.adapterID,
+	})
+}
+
+func (c *BluetoothClient) SetPairable(ctx context.Context, pairable bool, opts ...RequestOption) (*btgrpc.Adapter, error) {
+	o := newRequestOptions(opts)
+	return c.client.SetPairable(ctx, &btgrpc.SetPairableRequest{Pairable: pairable, AdapterId: o.adapterID})
+}
+
+// SetAlias sets the name other devices see the server as, an empty alias resets it
+func (c *BluetoothClient) SetAlias(ctx context.Context, alias string, opts ...RequestOption) (*btgrpc.Adapter, error) {
+	o := newRequestOptions(opts)
+	return c.client.SetAlias(ctx, &btgrpc.SetAliasRequest{Alias: alias, AdapterId: o.adapterID})
+}
+
+// PairingAgent answers the prompts the server shows while pairing devices, until ctx is cancelled or the
+// stream ends. Every prompt is handled in its own goroutine with a context that is cancelled when the
+// server withdraws the prompt. The response of a DISPLAY prompt is ignored, nil rejects any other prompt.
+func (c *BluetoothClient) PairingAgent(ctx context.Context, handle func(ctx context.Context, req *btgrpc.AgentRequest) *btgrpc.AgentResponse) error {
+	ctx, cancel := context.WithCancel(ctx)
+	stream, err := c.client.PairingAgent(ctx)
+	if err != nil {
+		cancel()
+		return err
+	}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	prompts := make(map[string]context.CancelFunc)
+	defer func() {
+		cancel()
+		wg.Wait()
+	}()
+
+	for {
+		req, err := stream.Recv()
+		if err != nil {
+			if err == io.EOF || ctx.Err() != nil {
+				return nil
+			}
+			return clientError(err)
+		}
+
+		if req.Type == btgrpc.AgentRequest_CANCEL {
+			mu.Lock()
+			if cancelPrompt, ok := prompts[req.Id]; ok {
+				cancelPrompt()
+				delete(prompts, req.Id)
+			}
+			mu.Unlock()
+			continue
+		}
+
+		promptCtx, cancelPrompt := context.WithCancel(ctx)
+		mu.Lock()
+		prompts[req.Id] = cancelPrompt
+		mu.Unlock()
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer cancelPrompt()
+
+			r := handle(promptCtx, req)
+
+			mu.Lock()
+			defer mu.Unlock()
+			delete(prompts, req.Id)
+
+			if promptCtx.Err() != nil || req.Type == btgrpc.AgentRequest_DISPLAY_PIN_CODE || req.Type == btgrpc.AgentRequest_DISPLAY_PASSKEY {
+				return
+			}
+			if r == nil {
+				r = &btgrpc.AgentResponse{}
+			}
+			r.Id = req.Id
+			if err := stream.Send(r); err != nil {
+				log.Println("Error answering pairing prompt: ", clientError(err))
+			}
+		}()
+	}
+}
+
+func checkResponse(r *btgrpc.Response, err error, failed error) error {
+	if err != nil {
+		return err
+	}
+
+	if !r.Success {
+		return failed
+	}
+
+	return nil
+}
+
+// ServerInfo returns the identity of the server
+func (c *BluetoothClient) ServerInfo(ctx context.Context) (*btgrpc.ServerInfo, error) {
+	return c.client.GetServerInfo(ctx, &btgrpc.Empty{})
+}
+
+// Health returns whether Bluetooth is usable on the server
+func (c *BluetoothClient) Health(ctx context.Context) (*btgrpc.Health, error) {
+	return c.client.GetHealth(ctx, &btgrpc.Empty{})
+}
+
+// UsePairedToken switches to the token issued by the server if the client has been paired with it.
+// Otherwise the shared secret keeps being used.
+func (c *BluetoothClient) UsePairedToken(ctx context.Context) error {
+	info, err := c.client.GetServerInfo(ctx, &btgrpc.Empty{})
+	if err != nil {
+		return err
+	}
+
+	if token, ok := c.tokens.Get(info.Id); ok {
+		c.setAuthorization(token)
+	}
+
+	return nil
+}
+
+// Pair pairs the client with the server under clientName. The server shows a code, which confirm must
+// return once the user has entered it. The issued token is stored and used from then on.
+func (c *BluetoothClient) Pair(ctx context.Context, clientName string, confirm func() (string, error)) error {
+	info, err := c.client.GetServerInfo(ctx, &btgrpc.Empty{})
+	if err != nil {
+		return err
+	}
+
+	r, err := c.client.PairClient(ctx, &btgrpc.PairClientRequest{ClientName: clientName})
+	if err != nil {
+		return err
+	}
+
+	code, err := confirm()
+	if err != nil {
+		return err
+	}
+
+	t, err := c.client.ConfirmPairing(ctx, &btgrpc.ConfirmPairingRequest{PairingId: r.PairingId, Code: code})
+	if err != nil {
+		return err
+	}
+
+	if err := c.tokens.Set(info.Id, t.Token); err != nil {
+		return err
+	}
+	c.setAuthorization(t.Token)
+
+	return nil
+}
+
+func (c *BluetoothClient) getAuthorization() string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.authorization
+}
+
+func (c *BluetoothClient) setAuthorization(authorization string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.authorization = authorization
+}
+
+// Methods that wait for the device itself and may take much longer than a regular request
+var connectMethods = map[string]bool{
+	"/grpc.Bluetooth/ConnectToDevice":      true,
+	"/grpc.Bluetooth/DisconnectFromDevice": true,
+	"/grpc.Bluetooth/PairDevice":           true,
+	"/grpc.Bluetooth/ConnectProfile":       true,
+	"/grpc.Bluetooth/DisconnectProfile":    true,
+}
+
+// timeoutClientInterceptor bounds calls made without a deadline by the configured default
+func timeoutClientInterceptor(requestTimeout, connectTimeout time.Duration) grpc.UnaryClientInterceptor {
 	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
-		md, ok := metadata.FromOutgoingContext(ctx)
-		if !ok {
-			md = metadata.New(nil)
+		timeout := requestTimeout
+		if connectMethods[method] {
+			timeout = connectTimeout
+		}
+
+		if _, ok := ctx.Deadline(); !ok && timeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
 		}
-		md.Append("Authorization", secret)
-		ctx = metadata.NewOutgoingContext(ctx, md)
 
 		return invoker(ctx, method, req, reply, cc, opts...)
 	}
 }
+
+func unaryClientInterceptor(authorization func() string) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return clientError(invoker(withSecret(ctx, authorization()), method, req, reply, cc, opts...))
+	}
+}
+
+func streamClientInterceptor(authorization func() string) grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		stream, err := streamer(withSecret(ctx, authorization()), desc, cc, method, opts...)
+		return stream, clientError(err)
+	}
+}
+
+func withSecret(ctx context.Context, secret string) context.Context {
+	md, ok := metadata.FromOutgoingContext(ctx)
+	if !ok {
+		md = metadata.New(nil)
+	}
+	md.Append("Authorization", secret)
+
+	return metadata.NewOutgoingContext(ctx, md)
+}
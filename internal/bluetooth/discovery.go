@@ -0,0 +1,139 @@
+package bluetooth
+
+import (
+	"log"
+	"sync"
+
+	"github.com/godbus/dbus/v5"
+	"github.com/muka/go-bluetooth/bluez/profile/adapter"
+)
+
+// discoveryClient is the discovery of an adapter by the server's D-Bus connection. BlueZ keeps one discovery
+// and one filter per connection, so Discover and every Observe share them: discovery runs while any of them
+// needs it, with a filter that lets through what all of them need.
+type discoveryClient struct {
+	adapter *adapter.Adapter1
+
+	mu   sync.Mutex
+	next int
+	// filters are the filters of the running sessions, nil for Discover
+	filters map[int]*ObserveFilter
+}
+
+var (
+	discoveriesMu sync.Mutex
+	discoveries   = make(map[dbus.ObjectPath]*discoveryClient)
+)
+
+// discoveryOf returns the discovery of the adapter, shared by every bluezAdapter of the same path
+func discoveryOf(a *adapter.Adapter1) *discoveryClient {
+	discoveriesMu.Lock()
+	defer discoveriesMu.Unlock()
+
+	d, ok := discoveries[a.Path()]
+	if !ok {
+		d = &discoveryClient{adapter: a, filters: make(map[int]*ObserveFilter)}
+		discoveries[a.Path()] = d
+	}
+
+	return d
+}
+
+// start adds a session to the discovery and starts it if it is the first one. A nil filter is a Discover,
+// which needs every device on any transport. release ends the session.
+func (d *discoveryClient) start(filter *ObserveFilter) (release func(), err error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	id := d.next
+	d.next++
+	d.filters[id] = filter
+
+	if err := d.adapter.SetDiscoveryFilter(d.mergedFilter()); err != nil {
+		delete(d.filters, id)
+		return nil, err
+	}
+	if len(d.filters) == 1 {
+		if err := d.adapter.StartDiscovery(); err != nil {
+			delete(d.filters, id)
+			d.resetFilter()
+			return nil, err
+		}
+	}
+
+	var once sync.Once
+	return func() {
+		once.Do(func() { d.release(id) })
+	}, nil
+}
+
+func (d *discoveryClient) release(id int) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	delete(d.filters, id)
+	if len(d.filters) > 0 {
+		if err := d.adapter.SetDiscoveryFilter(d.mergedFilter()); err != nil {
+			log.Println("Error updating discovery filter:", err)
+		}
+		return
+	}
+
+	if err := d.adapter.StopDiscovery(); err != nil {
+		log.Println("Error stopping discovery:", err)
+	}
+	d.resetFilter()
+}
+
+// resetFilter clears the filter, so that it does not narrow the next discovery
+func (d *discoveryClient) resetFilter() {
+	if err := d.adapter.SetDiscoveryFilter(map[string]interface{}{}); err != nil {
+		log.Println("Error clearing discovery filter:", err)
+	}
+}
+
+// mergedFilter is the filter that lets through what every session needs. Observations filter again on
+// their own, so the merged filter may let through more than each of them wants.
+func (d *discoveryClient) mergedFilter() map[string]interface{} {
+	le, duplicates := true, false
+	anyUUID, anyRSSI := false, false
+	var uuids []string
+	var rssi int16
+
+	for _, f := range d.filters {
+		if f == nil {
+			le, anyUUID, anyRSSI = false, true, true
+			continue
+		}
+		duplicates = true
+		if len(f.UUIDs) == 0 {
+			anyUUID = true
+		}
+		for _, uuid := range f.UUIDs {
+			if !contains(uuids, uuid) {
+				uuids = append(uuids, uuid)
+			}
+		}
+		if f.RSSI == 0 {
+			anyRSSI = true
+		} else if rssi == 0 || f.RSSI < rssi {
+			rssi = f.RSSI
+		}
+	}
+
+	filter := map[string]interface{}{}
+	if le {
+		filter["Transport"] = "le"
+	}
+	if duplicates {
+		filter["DuplicateData"] = true
+	}
+	if !anyUUID && len(uuids) > 0 {
+		filter["UUIDs"] = uuids
+	}
+	if !anyRSSI && rssi != 0 {
+		filter["RSSI"] = rssi
+	}
+
+	return filter
+}
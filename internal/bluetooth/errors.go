@@ -0,0 +1,298 @@
+package bluetooth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/godbus/dbus/v5"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/andree-bjorkgard/remote-bluetooth/internal/pairing"
+)
+
+const errorDomain = "remote-bluetooth"
+
+// Reasons sent in the ErrorInfo detail of every error returned by the server
+const (
+	ReasonDeviceNotFound         = "DEVICE_NOT_FOUND"
+	ReasonDeviceUnavailable      = "DEVICE_UNAVAILABLE"
+	ReasonAdapterNotFound        = "ADAPTER_NOT_FOUND"
+	ReasonAdapterNotReady        = "ADAPTER_NOT_READY"
+	ReasonNotConnected           = "NOT_CONNECTED"
+	ReasonAlreadyConnected       = "ALREADY_CONNECTED"
+	ReasonInProgress             = "IN_PROGRESS"
+	ReasonTimeout                = "TIMEOUT"
+	ReasonUnauthenticated        = "UNAUTHENTICATED"
+	ReasonPermissionDenied       = "PERMISSION_DENIED"
+	ReasonPairingFailed          = "PAIRING_FAILED"
+	ReasonInvalidName            = "INVALID_NAME"
+	ReasonNameTaken              = "NAME_TAKEN"
+	ReasonTooManyPairings        = "TOO_MANY_PAIRINGS"
+	ReasonUnknownProfile         = "UNKNOWN_PROFILE"
+	ReasonProfileNotSupported    = "PROFILE_NOT_SUPPORTED"
+	ReasonNoMediaPlayer          = "NO_MEDIA_PLAYER"
+	ReasonTransportNotFound      = "TRANSPORT_NOT_FOUND"
+	ReasonVolumeNotSupported     = "VOLUME_NOT_SUPPORTED"
+	ReasonInvalidVolume          = "INVALID_VOLUME"
+	ReasonCharacteristicNotFound = "CHARACTERISTIC_NOT_FOUND"
+	ReasonAmbiguousUUID          = "AMBIGUOUS_UUID"
+	ReasonNotPermitted           = "NOT_PERMITTED"
+	ReasonBluetoothUnavailable   = "BLUETOOTH_UNAVAILABLE"
+	ReasonInternal               = "INTERNAL"
+)
+
+var (
+	ErrDeviceUnavailable = errors.New("device unavailable")
+	ErrAdapterNotReady   = errors.New("adapter not ready")
+	ErrNotConnected      = errors.New("device not connected")
+	ErrAlreadyConnected  = errors.New("device already connected")
+	ErrInProgress        = errors.New("operation already in progress")
+	ErrTimeout           = errors.New("operation timed out")
+	ErrUnauthenticated   = errors.New("unauthenticated")
+	ErrPermissionDenied  = errors.New("permission denied")
+)
+
+// BlueZ reports these messages with org.bluez.Error.Failed when the device does not answer
+var unavailableMessages = []string{
+	"host is down",
+	"page-timeout",
+	"page timeout",
+	"connection refused",
+	"connection-abort",
+	"software caused connection abort",
+	"no route to host",
+}
+
+// deviceError converts an error from the adapter into a gRPC status with an ErrorInfo detail.
+// Errors that already carry a status are returned unchanged.
+func deviceError(err error, address string) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	code, reason := classify(err)
+	metadata := map[string]string{}
+	if address != "" {
+		metadata["address"] = address
+	}
+	if name, _, ok := dbusError(err); ok {
+		metadata["dbusError"] = name
+	}
+
+	return newStatus(code, reason, metadata, err.Error())
+}
+
+func newStatus(code codes.Code, reason string, metadata map[string]string, msg string) error {
+	st := status.New(code, msg)
+	detailed, err := st.WithDetails(&errdetails.ErrorInfo{Reason: reason, Domain: errorDomain, Metadata: metadata})
+	if err != nil {
+		return st.Err()
+	}
+
+	return detailed.Err()
+}
+
+func classify(err error) (codes.Code, string) {
+	switch {
+	case errors.Is(err, ErrDeviceNotFound):
+		return codes.NotFound, ReasonDeviceNotFound
+	case errors.Is(err, ErrAdapterNotFound):
+		return codes.NotFound, ReasonAdapterNotFound
+	case errors.Is(err, ErrBluetoothUnavailable):
+		return codes.Unavailable, ReasonBluetoothUnavailable
+	case errors.Is(err, ErrUnknownProfile):
+		return codes.InvalidArgument, ReasonUnknownProfile
+	case errors.Is(err, ErrProfileNotSupported):
+		return codes.FailedPrecondition, ReasonProfileNotSupported
+	case errors.Is(err, ErrNoMediaPlayer):
+		return codes.FailedPrecondition, ReasonNoMediaPlayer
+	case errors.Is(err, ErrMediaTransportNotFound):
+		return codes.NotFound, ReasonTransportNotFound
+	case errors.Is(err, ErrVolumeNotSupported):
+		return codes.FailedPrecondition, ReasonVolumeNotSupported
+	case errors.Is(err, ErrInvalidVolume):
+		return codes.InvalidArgument, ReasonInvalidVolume
+	case errors.Is(err, ErrCharacteristicNotFound):
+		return codes.NotFound, ReasonCharacteristicNotFound
+	case errors.Is(err, ErrAmbiguousCharacteristic):
+		return codes.InvalidArgument, ReasonAmbiguousUUID
+	case errors.Is(err, ErrNotPermitted):
+		return codes.FailedPrecondition, ReasonNotPermitted
+	case errors.Is(err, ErrNotConnected):
+		return codes.FailedPrecondition, ReasonNotConnected
+	case errors.Is(err, context.DeadlineExceeded):
+		return codes.DeadlineExceeded, ReasonTimeout
+	case errors.Is(err, context.Canceled):
+		return codes.Canceled, ReasonInternal
+	}
+
+	name, msg, ok := dbusError(err)
+	if !ok {
+		return codes.Internal, ReasonInternal
+	}
+
+	switch name {
+	case "org.bluez.Error.DoesNotExist":
+		return codes.NotFound, ReasonDeviceNotFound
+	case "org.bluez.Error.NotReady":
+		return codes.FailedPrecondition, ReasonAdapterNotReady
+	case "org.bluez.Error.NotConnected":
+		return codes.FailedPrecondition, ReasonNotConnected
+	case "org.bluez.Error.AlreadyConnected":
+		return codes.AlreadyExists, ReasonAlreadyConnected
+	case "org.bluez.Error.InProgress":
+		return codes.Aborted, ReasonInProgress
+	case "org.bluez.Error.NotPermitted", "org.bluez.Error.NotAuthorized":
+		return codes.FailedPrecondition, ReasonNotPermitted
+	case "org.bluez.Error.NotAvailable":
+		return codes.Unavailable, ReasonDeviceUnavailable
+	case "org.bluez.Error.AuthenticationFailed", "org.bluez.Error.AuthenticationRejected",
+		"org.bluez.Error.AuthenticationCanceled", "org.bluez.Error.AuthenticationTimeout":
+		return codes.FailedPrecondition, ReasonPairingFailed
+	case "org.freedesktop.DBus.Error.NoReply", "org.freedesktop.DBus.Error.Timeout":
+		return codes.DeadlineExceeded, ReasonTimeout
+	case "org.freedesktop.DBus.Error.ServiceUnknown", "org.freedesktop.DBus.Error.NameHasNoOwner":
+		return codes.Unavailable, ReasonBluetoothUnavailable
+	case "org.bluez.Error.Failed":
+		lower := strings.ToLower(msg)
+		for _, m := range unavailableMessages {
+			if strings.Contains(lower, m) {
+				return codes.Unavailable, ReasonDeviceUnavailable
+			}
+		}
+		if strings.Contains(lower, "timeout") || strings.Contains(lower, "timed out") {
+			return codes.DeadlineExceeded, ReasonTimeout
+		}
+	}
+
+	return codes.Internal, ReasonInternal
+}
+
+func dbusError(err error) (name, msg string, ok bool) {
+	var e dbus.Error
+	var pe *dbus.Error
+	switch {
+	case errors.As(err, &e):
+	case errors.As(err, &pe) && pe != nil:
+		e = *pe
+	default:
+		return "", "", false
+	}
+
+	if len(e.Body) > 0 {
+		msg, _ = e.Body[0].(string)
+	}
+
+	return e.Name, msg, true
+}
+
+// clientError wraps an error returned by the server with the sentinel error matching its status,
+// so callers can use errors.Is while the status stays available through status.FromError.
+// Codes without a reason are only mapped when they cannot come from the transport itself.
+func clientError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+
+	sentinel := sentinelForReason(errorReason(st))
+	if sentinel == nil {
+		sentinel = sentinelForCode(st.Code())
+	}
+	if sentinel == nil {
+		return err
+	}
+
+	return fmt.Errorf("%w: %w", sentinel, err)
+}
+
+func errorReason(st *status.Status) string {
+	for _, d := range st.Details() {
+		if info, ok := d.(*errdetails.ErrorInfo); ok && info.Domain == errorDomain {
+			return info.Reason
+		}
+	}
+
+	return ""
+}
+
+func sentinelForReason(reason string) error {
+	switch reason {
+	case ReasonDeviceNotFound:
+		return ErrDeviceNotFound
+	case ReasonDeviceUnavailable:
+		return ErrDeviceUnavailable
+	case ReasonBluetoothUnavailable:
+		return ErrBluetoothUnavailable
+	case ReasonAdapterNotFound:
+		return ErrAdapterNotFound
+	case ReasonAdapterNotReady:
+		return ErrAdapterNotReady
+	case ReasonNotConnected:
+		return ErrNotConnected
+	case ReasonAlreadyConnected:
+		return ErrAlreadyConnected
+	case ReasonInProgress:
+		return ErrInProgress
+	case ReasonTimeout:
+		return ErrTimeout
+	case ReasonUnauthenticated:
+		return ErrUnauthenticated
+	case ReasonPermissionDenied:
+		return ErrPermissionDenied
+	case ReasonPairingFailed:
+		return ErrPairingFailed
+	case ReasonInvalidName:
+		return pairing.ErrInvalidName
+	case ReasonNameTaken:
+		return pairing.ErrNameTaken
+	case ReasonTooManyPairings:
+		return pairing.ErrTooManyPairings
+	case ReasonUnknownProfile:
+		return ErrUnknownProfile
+	case ReasonProfileNotSupported:
+		return ErrProfileNotSupported
+	case ReasonNoMediaPlayer:
+		return ErrNoMediaPlayer
+	case ReasonTransportNotFound:
+		return ErrMediaTransportNotFound
+	case ReasonVolumeNotSupported:
+		return ErrVolumeNotSupported
+	case ReasonInvalidVolume:
+		return ErrInvalidVolume
+	case ReasonCharacteristicNotFound:
+		return ErrCharacteristicNotFound
+	case ReasonAmbiguousUUID:
+		return ErrAmbiguousCharacteristic
+	case ReasonNotPermitted:
+		return ErrNotPermitted
+	}
+
+	return nil
+}
+
+func sentinelForCode(code codes.Code) error {
+	switch code {
+	case codes.NotFound:
+		return ErrDeviceNotFound
+	case codes.DeadlineExceeded:
+		return ErrTimeout
+	case codes.Unauthenticated:
+		return ErrUnauthenticated
+	case codes.PermissionDenied:
+		return ErrPermissionDenied
+	}
+
+	return nil
+}
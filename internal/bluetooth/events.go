@@ -0,0 +1,41 @@
+package bluetooth
+
+import "sync"
+
+// broadcaster delivers events to every subscriber. A subscriber that falls behind misses events
+// rather than stalling the publisher.
+type broadcaster[T any] struct {
+	mu   sync.Mutex
+	subs map[chan T]struct{}
+}
+
+func newBroadcaster[T any]() *broadcaster[T] {
+	return &broadcaster[T]{subs: make(map[chan T]struct{})}
+}
+
+func (b *broadcaster[T]) subscribe() (<-chan T, func()) {
+	ch := make(chan T, 10)
+
+	b.mu.Lock()
+	b.subs[ch] = struct{}{}
+	b.mu.Unlock()
+
+	return ch, func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+
+		delete(b.subs, ch)
+	}
+}
+
+func (b *broadcaster[T]) publish(v T) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for ch := range b.subs {
+		select {
+		case ch <- v:
+		default:
+		}
+	}
+}
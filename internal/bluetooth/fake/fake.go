@@ -0,0 +1,972 @@
+// Package fake provides an in-memory bluetooth.Adapter for running the server without Bluetooth hardware.
+package fake
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/andree-bjorkgard/remote-bluetooth/internal/bluetooth"
+)
+
+var (
+	ErrNoBattery      = errors.New("device has no battery")
+	ErrOutOfRange     = errors.New("device is not in range")
+	ErrServiceStopped = errors.New("bluetooth service is not running")
+)
+
+// AdapterProperties are the adapter properties the fake exposes.
+type AdapterProperties struct {
+	ID                  string
+	Address             string
+	Name                string
+	Alias               string
+	Powered             bool
+	Discoverable        bool
+	DiscoverableTimeout uint32
+	Pairable            bool
+}
+
+// Adapter is a scriptable in-memory adapter.
+type Adapter struct {
+	mu sync.Mutex
+
+	props         AdapterProperties
+	setErr        error
+	devices       []*Device
+	getDevicesErr error
+	discoverErr   error
+	latency       time.Duration
+	powerOnDelay  time.Duration
+	scans         map[chan bluetooth.Device]struct{}
+	observers     map[chan bluetooth.Advertisement]struct{}
+	deviceWatches map[*deviceWatch]struct{}
+	agent         bluetooth.Agent
+}
+
+var _ bluetooth.Adapter = (*Adapter)(nil)
+
+func NewAdapter(devices ...*Device) *Adapter {
+	props := AdapterProperties{ID: "hci0", Address: "00:00:00:00:00:00", Name: "fake", Powered: true, Pairable: true}
+	return &Adapter{
+		props:     props,
+		devices:   devices,
+		scans:     make(map[chan bluetooth.Device]struct{}),
+		observers: make(map[chan bluetooth.Advertisement]struct{}),
+
+		deviceWatches: make(map[*deviceWatch]struct{}),
+	}
+}
+
+// UpdateAdapter changes the adapter properties.
+func (a *Adapter) UpdateAdapter(fn func(p *AdapterProperties)) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	fn(&a.props)
+}
+
+// AdapterProperties returns the adapter properties.
+func (a *Adapter) AdapterProperties() AdapterProperties {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return a.props
+}
+
+// FailSet makes every adapter setter return err until called again with nil.
+func (a *Adapter) FailSet(err error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.setErr = err
+}
+
+func (a *Adapter) set(fn func(p *AdapterProperties)) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.setErr != nil {
+		return a.setErr
+	}
+	fn(&a.props)
+
+	return nil
+}
+
+func (a *Adapter) GetID() string {
+	return a.AdapterProperties().ID
+}
+
+func (a *Adapter) GetAddress() (string, error) {
+	return a.AdapterProperties().Address, nil
+}
+
+func (a *Adapter) GetName() (string, error) {
+	return a.AdapterProperties().Name, nil
+}
+
+// GetAlias falls back to the name like BlueZ does.
+func (a *Adapter) GetAlias() (string, error) {
+	p := a.AdapterProperties()
+	if p.Alias == "" {
+		return p.Name, nil
+	}
+
+	return p.Alias, nil
+}
+
+func (a *Adapter) GetPowered() (bool, error) {
+	return a.AdapterProperties().Powered, nil
+}
+
+func (a *Adapter) GetDiscoverable() (bool, error) {
+	return a.AdapterProperties().Discoverable, nil
+}
+
+func (a *Adapter) GetDiscoverableTimeout() (uint32, error) {
+	return a.AdapterProperties().DiscoverableTimeout, nil
+}
+
+func (a *Adapter) GetPairable() (bool, error) {
+	return a.AdapterProperties().Pairable, nil
+}
+
+func (a *Adapter) GetDiscovering() (bool, error) {
+	return a.Discovering(), nil
+}
+
+// DelayPowerOn makes SetPowered(true) return before the adapter is powered, which it only reports after
+// the delay, like a controller that is slow to come up. A negative delay never powers the adapter on.
+func (a *Adapter) DelayPowerOn(delay time.Duration) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.powerOnDelay = delay
+}
+
+func (a *Adapter) SetPowered(powered bool) error {
+	a.mu.Lock()
+	delay := a.powerOnDelay
+	a.mu.Unlock()
+
+	if !powered || delay == 0 {
+		return a.set(func(p *AdapterProperties) { p.Powered = powered })
+	}
+
+	if err := a.set(func(*AdapterProperties) {}); err != nil {
+		return err
+	}
+	if delay > 0 {
+		time.AfterFunc(delay, func() { a.UpdateAdapter(func(p *AdapterProperties) { p.Powered = true }) })
+	}
+
+	return nil
+}
+
+func (a *Adapter) SetDiscoverable(discoverable bool) error {
+	return a.set(func(p *AdapterProperties) { p.Discoverable = discoverable })
+}
+
+func (a *Adapter) SetDiscoverableTimeout(seconds uint32) error {
+	return a.set(func(p *AdapterProperties) { p.DiscoverableTimeout = seconds })
+}
+
+func (a *Adapter) SetPairable(pairable bool) error {
+	return a.set(func(p *AdapterProperties) { p.Pairable = pairable })
+}
+
+func (a *Adapter) SetAlias(alias string) error {
+	return a.set(func(p *AdapterProperties) { p.Alias = alias })
+}
+
+// AddDevice adds a device, which is reported to every device watch and as found if discovery is running.
+func (a *Adapter) AddDevice(d *Device) {
+	a.mu.Lock()
+	a.devices = append(a.devices, d)
+	for w := range a.deviceWatches {
+		select {
+		case w.added <- d:
+		default:
+		}
+	}
+	a.mu.Unlock()
+
+	a.See(d)
+}
+
+// RemoveDevice removes the device and reports it to every device watch.
+func (a *Adapter) RemoveDevice(address string) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	for i, d := range a.devices {
+		if addr, _ := d.GetAddress(); addr == address {
+			a.devices = append(a.devices[:i], a.devices[i+1:]...)
+			for w := range a.deviceWatches {
+				select {
+				case w.removed <- address:
+				default:
+				}
+			}
+			return nil
+		}
+	}
+
+	return bluetooth.ErrDeviceNotFound
+}
+
+type deviceWatch struct {
+	added   chan bluetooth.Device
+	removed chan string
+}
+
+func (a *Adapter) WatchDevices() (<-chan bluetooth.Device, <-chan string, func(), error) {
+	w := &deviceWatch{added: make(chan bluetooth.Device, 10), removed: make(chan string, 10)}
+
+	a.mu.Lock()
+	a.deviceWatches[w] = struct{}{}
+	a.mu.Unlock()
+
+	return w.added, w.removed, func() {
+		a.mu.Lock()
+		defer a.mu.Unlock()
+
+		delete(a.deviceWatches, w)
+	}, nil
+}
+
+// See reports the device as found to every running discovery.
+func (a *Adapter) See(d *Device) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	for ch := range a.scans {
+		select {
+		case ch <- d:
+		default:
+		}
+	}
+}
+
+// Discovering reports whether discovery is running.
+func (a *Adapter) Discovering() bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return len(a.scans) > 0
+}
+
+// FailDiscover makes Discover and Observe return err until called again with nil.
+func (a *Adapter) FailDiscover(err error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.discoverErr = err
+}
+
+func (a *Adapter) Discover() (<-chan bluetooth.Device, func(), error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.discoverErr != nil {
+		return nil, nil, a.discoverErr
+	}
+
+	ch := make(chan bluetooth.Device, 10)
+	a.scans[ch] = struct{}{}
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			a.mu.Lock()
+			delete(a.scans, ch)
+			a.mu.Unlock()
+			close(ch)
+		})
+	}, nil
+}
+
+// Advertise sends the advertisement to every running observation, like BlueZ the filter is up to the
+// observer.
+func (a *Adapter) Advertise(ad bluetooth.Advertisement) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	for ch := range a.observers {
+		select {
+		case ch <- ad:
+		default:
+		}
+	}
+}
+
+// Observing returns the number of running observations.
+func (a *Adapter) Observing() int {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return len(a.observers)
+}
+
+func (a *Adapter) Observe(_ bluetooth.ObserveFilter) (<-chan bluetooth.Advertisement, func(), error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.discoverErr != nil {
+		return nil, nil, a.discoverErr
+	}
+
+	ch := make(chan bluetooth.Advertisement, 10)
+	a.observers[ch] = struct{}{}
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			a.mu.Lock()
+			delete(a.observers, ch)
+			a.mu.Unlock()
+			close(ch)
+		})
+	}, nil
+}
+
+// FailGetDevices makes GetDevices return err until called again with nil.
+func (a *Adapter) FailGetDevices(err error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.getDevicesErr = err
+}
+
+// SetLatency delays every adapter call by d.
+func (a *Adapter) SetLatency(d time.Duration) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.latency = d
+}
+
+func (a *Adapter) GetDevices() ([]bluetooth.Device, error) {
+	a.mu.Lock()
+	latency, err := a.latency, a.getDevicesErr
+	devs := make([]bluetooth.Device, 0, len(a.devices))
+	for _, d := range a.devices {
+		devs = append(devs, d)
+	}
+	a.mu.Unlock()
+
+	time.Sleep(latency)
+	if err != nil {
+		return nil, err
+	}
+
+	return devs, nil
+}
+
+func (a *Adapter) GetDeviceByAddress(address string) (bluetooth.Device, error) {
+	devs, err := a.GetDevices()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, d := range devs {
+		if addr, _ := d.GetAddress(); addr == address {
+			return d, nil
+		}
+	}
+
+	return nil, bluetooth.ErrDeviceNotFound
+}
+
+func (a *Adapter) RegisterAgent(agent bluetooth.Agent) (func(), error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.agent = agent
+
+	return func() {
+		a.mu.Lock()
+		defer a.mu.Unlock()
+
+		a.agent = nil
+	}, nil
+}
+
+// Agent returns the registered agent, which tests can prompt like BlueZ would, or nil.
+func (a *Adapter) Agent() bluetooth.Agent {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return a.agent
+}
+
+// Properties are the device properties the fake exposes.
+type Properties struct {
+	Address   string
+	Name      string
+	Alias     string
+	Class     uint32
+	Icon      string
+	Trusted   bool
+	Paired    bool
+	Connected bool
+	Blocked   bool
+	UUIDs     []string
+	// ConnectedProfiles are the UUIDs of the connected profiles, Connect connects all of UUIDs
+	ConnectedProfiles []string
+
+	Appearance    uint16
+	LegacyPairing bool
+	Modalias      string
+	AdapterID     string
+
+	// RSSI and TxPower are nil if the device is not in range.
+	RSSI    *int16
+	TxPower *int16
+
+	// Battery is the battery percentage, nil if the device has no battery.
+	Battery       *byte
+	BatterySource string
+}
+
+// Device is a scriptable in-memory device.
+type Device struct {
+	mu sync.Mutex
+
+	props         Properties
+	connectErr    error
+	disconnectErr error
+	keepConnected bool
+	pairErr       error
+	latency       time.Duration
+	player        *MediaPlayer
+	transports    []*MediaTransport
+	services      []*GattService
+	watchers      map[chan struct{}]struct{}
+}
+
+var _ bluetooth.Device = (*Device)(nil)
+
+func NewDevice(props Properties) *Device {
+	return &Device{props: props, watchers: make(map[chan struct{}]struct{})}
+}
+
+// Update changes the device properties and notifies all watchers.
+func (d *Device) Update(fn func(p *Properties)) {
+	d.mu.Lock()
+	fn(&d.props)
+	d.mu.Unlock()
+
+	d.notify()
+}
+
+// FailConnect makes Connect return err until called again with nil.
+func (d *Device) FailConnect(err error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.connectErr = err
+}
+
+// FailPair makes Pair return err until called again with nil.
+func (d *Device) FailPair(err error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.pairErr = err
+}
+
+// FailDisconnect makes Disconnect return err until called again with nil.
+func (d *Device) FailDisconnect(err error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.disconnectErr = err
+}
+
+// KeepConnected makes Disconnect succeed but leave the device connected, like a device that reconnects at
+// once, until called again with false.
+func (d *Device) KeepConnected(keep bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.keepConnected = keep
+}
+
+// SetLatency delays Connect, Disconnect and Pair by l, unless their context is done first.
+func (d *Device) SetLatency(l time.Duration) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.latency = l
+}
+
+func (d *Device) Properties() Properties {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.props
+}
+
+func (d *Device) GetAddress() (string, error) {
+	return d.Properties().Address, nil
+}
+
+func (d *Device) GetName() (string, error) {
+	return d.Properties().Name, nil
+}
+
+// GetAlias falls back to the name like BlueZ does.
+func (d *Device) GetAlias() (string, error) {
+	p := d.Properties()
+	if p.Alias == "" {
+		return p.Name, nil
+	}
+
+	return p.Alias, nil
+}
+
+func (d *Device) GetClass() (uint32, error) {
+	return d.Properties().Class, nil
+}
+
+func (d *Device) GetBlocked() (bool, error) {
+	return d.Properties().Blocked, nil
+}
+
+func (d *Device) GetTrusted() (bool, error) {
+	return d.Properties().Trusted, nil
+}
+
+func (d *Device) GetPaired() (bool, error) {
+	return d.Properties().Paired, nil
+}
+
+func (d *Device) GetConnected() (bool, error) {
+	return d.Properties().Connected, nil
+}
+
+func (d *Device) GetIcon() (string, error) {
+	return d.Properties().Icon, nil
+}
+
+func (d *Device) GetUUIDs() ([]string, error) {
+	return d.Properties().UUIDs, nil
+}
+
+func (d *Device) GetBatteryPercentage() (byte, error) {
+	b := d.Properties().Battery
+	if b == nil {
+		return 0, ErrNoBattery
+	}
+
+	return *b, nil
+}
+
+func (d *Device) GetRSSI() (int16, error) {
+	r := d.Properties().RSSI
+	if r == nil {
+		return 0, ErrOutOfRange
+	}
+
+	return *r, nil
+}
+
+func (d *Device) GetTxPower() (int16, error) {
+	t := d.Properties().TxPower
+	if t == nil {
+		return 0, ErrOutOfRange
+	}
+
+	return *t, nil
+}
+
+func (d *Device) GetAppearance() (uint16, error) {
+	return d.Properties().Appearance, nil
+}
+
+func (d *Device) GetLegacyPairing() (bool, error) {
+	return d.Properties().LegacyPairing, nil
+}
+
+func (d *Device) GetModalias() (string, error) {
+	return d.Properties().Modalias, nil
+}
+
+func (d *Device) GetAdapterID() (string, error) {
+	return d.Properties().AdapterID, nil
+}
+
+func (d *Device) SetTrusted(trusted bool) error {
+	d.Update(func(p *Properties) { p.Trusted = trusted })
+
+	return nil
+}
+
+// Pair honors the latency set with SetLatency like Connect does.
+func (d *Device) Pair(ctx context.Context) error {
+	d.mu.Lock()
+	latency, err := d.latency, d.pairErr
+	d.mu.Unlock()
+
+	select {
+	case <-time.After(latency):
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	if err != nil {
+		return err
+	}
+
+	d.Update(func(p *Properties) { p.Paired = true })
+
+	return nil
+}
+
+func (d *Device) GetBatterySource() (string, error) {
+	p := d.Properties()
+	if p.Battery == nil {
+		return "", ErrNoBattery
+	}
+
+	return p.BatterySource, nil
+}
+
+func (d *Device) Connect(ctx context.Context) error {
+	return d.setConnected(ctx, true)
+}
+
+func (d *Device) Disconnect(ctx context.Context) error {
+	return d.setConnected(ctx, false)
+}
+
+func (d *Device) setConnected(ctx context.Context, connected bool) error {
+	d.mu.Lock()
+	latency, err := d.latency, d.connectErr
+	if !connected {
+		err = d.disconnectErr
+	}
+	keep := !connected && d.keepConnected
+	d.mu.Unlock()
+
+	select {
+	case <-time.After(latency):
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	if err != nil || keep {
+		return err
+	}
+
+	d.Update(func(p *Properties) {
+		p.Connected = connected
+		p.ConnectedProfiles = nil
+		if connected {
+			p.ConnectedProfiles = append([]string(nil), p.UUIDs...)
+		}
+	})
+
+	return nil
+}
+
+func (d *Device) GetConnectedProfiles() ([]string, error) {
+	return d.Properties().ConnectedProfiles, nil
+}
+
+// ConnectProfile fails like Connect does and honors the same latency.
+func (d *Device) ConnectProfile(ctx context.Context, uuid string) error {
+	return d.setProfileConnected(ctx, uuid, true)
+}
+
+// DisconnectProfile disconnects the device once its last profile is disconnected.
+func (d *Device) DisconnectProfile(ctx context.Context, uuid string) error {
+	return d.setProfileConnected(ctx, uuid, false)
+}
+
+func (d *Device) setProfileConnected(ctx context.Context, uuid string, connected bool) error {
+	d.mu.Lock()
+	latency, err := d.latency, d.connectErr
+	if !connected {
+		err = d.disconnectErr
+	}
+	keep := !connected && d.keepConnected
+	d.mu.Unlock()
+
+	select {
+	case <-time.After(latency):
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	if err != nil || keep {
+		return err
+	}
+
+	d.Update(func(p *Properties) {
+		var profiles []string
+		for _, u := range p.ConnectedProfiles {
+			if u != uuid {
+				profiles = append(profiles, u)
+			}
+		}
+		if connected {
+			profiles = append(profiles, uuid)
+		}
+		p.ConnectedProfiles = profiles
+		p.Connected = len(profiles) > 0
+	})
+
+	return nil
+}
+
+// SetMediaPlayer makes player the player of the device, nil removes it, and notifies all watchers.
+func (d *Device) SetMediaPlayer(player *MediaPlayer) {
+	d.mu.Lock()
+	d.player = player
+	d.mu.Unlock()
+
+	d.notify()
+}
+
+// GetMediaPlayer returns the player set with SetMediaPlayer while the device is connected.
+func (d *Device) GetMediaPlayer() (bluetooth.MediaPlayer, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.player == nil || !d.props.Connected {
+		return nil, bluetooth.ErrNoMediaPlayer
+	}
+
+	return d.player, nil
+}
+
+// SetMediaTransports replaces the transports of the device and notifies all watchers.
+func (d *Device) SetMediaTransports(transports ...*MediaTransport) {
+	d.mu.Lock()
+	d.transports = transports
+	d.mu.Unlock()
+
+	d.notify()
+}
+
+// GetMediaTransports returns the transports set with SetMediaTransports while the device is connected.
+func (d *Device) GetMediaTransports() ([]bluetooth.MediaTransport, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if !d.props.Connected {
+		return nil, nil
+	}
+
+	transports := make([]bluetooth.MediaTransport, 0, len(d.transports))
+	for _, t := range d.transports {
+		transports = append(transports, t)
+	}
+
+	return transports, nil
+}
+
+func (d *Device) WatchChanges() (<-chan struct{}, func(), error) {
+	ch := make(chan struct{}, 1)
+
+	d.mu.Lock()
+	d.watchers[ch] = struct{}{}
+	d.mu.Unlock()
+
+	return ch, func() {
+		d.mu.Lock()
+		delete(d.watchers, ch)
+		d.mu.Unlock()
+	}, nil
+}
+
+func (d *Device) notify() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for ch := range d.watchers {
+		select {
+		case ch <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// BatteryProvider is a scriptable battery provider.
+type BatteryProvider struct {
+	mu     sync.Mutex
+	levels map[string]batteryLevel
+}
+
+type batteryLevel struct {
+	percentage byte
+	source     string
+}
+
+var _ bluetooth.BatteryProvider = (*BatteryProvider)(nil)
+
+func NewBatteryProvider() *BatteryProvider {
+	return &BatteryProvider{levels: make(map[string]batteryLevel)}
+}
+
+// Set makes the provider report the level for the device with the given address.
+func (p *BatteryProvider) Set(address string, percentage byte, source string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.levels[address] = batteryLevel{percentage: percentage, source: source}
+}
+
+// Clear makes the level of the device unknown again.
+func (p *BatteryProvider) Clear(address string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	delete(p.levels, address)
+}
+
+func (p *BatteryProvider) BatteryLevel(dev bluetooth.Device) (byte, string, bool) {
+	addr, _ := dev.GetAddress()
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	l, ok := p.levels[addr]
+	return l.percentage, l.source, ok
+}
+
+// Adapters is a scriptable adapter source, adapters can be plugged in and removed and the Bluetooth
+// service stopped and started while the server runs.
+type Adapters struct {
+	mu       sync.Mutex
+	adapters []*Adapter
+	stopped  bool
+	added    chan bluetooth.Adapter
+	removed  chan string
+	running  chan bool
+}
+
+var _ bluetooth.AdapterSource = (*Adapters)(nil)
+
+func NewAdapters(adapters ...*Adapter) *Adapters {
+	return &Adapters{adapters: adapters}
+}
+
+// Adapters fails with ErrServiceStopped while the service is stopped.
+func (s *Adapters) Adapters() ([]bluetooth.Adapter, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.stopped {
+		return nil, ErrServiceStopped
+	}
+
+	adapters := make([]bluetooth.Adapter, 0, len(s.adapters))
+	for _, a := range s.adapters {
+		adapters = append(adapters, a)
+	}
+
+	return adapters, nil
+}
+
+// WatchAdapters supports a single watcher at a time.
+func (s *Adapters) WatchAdapters() (<-chan bluetooth.Adapter, <-chan string, func(), error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	added := make(chan bluetooth.Adapter, 10)
+	removed := make(chan string, 10)
+	s.added, s.removed = added, removed
+
+	return added, removed, func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		if s.added == added {
+			s.added, s.removed = nil, nil
+		}
+	}, nil
+}
+
+// WatchService supports a single watcher at a time.
+func (s *Adapters) WatchService() (<-chan bool, func(), error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	running := make(chan bool, 10)
+	s.running = running
+
+	return running, func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		if s.running == running {
+			s.running = nil
+		}
+	}, nil
+}
+
+// SetRunning starts or stops the Bluetooth service. The adapters stay plugged in while it is stopped.
+func (s *Adapters) SetRunning(running bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.stopped = !running
+	if s.running != nil {
+		s.running <- running
+	}
+}
+
+// Plug adds the adapter as if it was plugged in.
+func (s *Adapters) Plug(a *Adapter) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.adapters = append(s.adapters, a)
+	if s.added != nil {
+		s.added <- a
+	}
+}
+
+// Unplug removes the adapter with the id as if it was unplugged.
+func (s *Adapters) Unplug(id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i, a := range s.adapters {
+		if a.GetID() == id {
+			s.adapters = append(s.adapters[:i], s.adapters[i+1:]...)
+			break
+		}
+	}
+	if s.removed != nil {
+		s.removed <- id
+	}
+}
+
+// SetGattServices replaces the GATT services of the device and notifies all watchers.
+func (d *Device) SetGattServices(services ...*GattService) {
+	d.mu.Lock()
+	d.services = services
+	d.mu.Unlock()
+
+	d.notify()
+}
+
+// GetGattServices returns the services set with SetGattServices while the device is connected.
+func (d *Device) GetGattServices() ([]bluetooth.GattService, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if !d.props.Connected {
+		return nil, nil
+	}
+
+	services := make([]bluetooth.GattService, 0, len(d.services))
+	for _, s := range d.services {
+		services = append(services, s)
+	}
+
+	return services, nil
+}
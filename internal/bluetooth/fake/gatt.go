@@ -0,0 +1,189 @@
+package fake
+
+import (
+	"context"
+	"sync"
+
+	"github.com/andree-bjorkgard/remote-bluetooth/internal/bluetooth"
+)
+
+// GattService is an in-memory GATT service.
+type GattService struct {
+	ID              string
+	UUID            string
+	Primary         bool
+	Characteristics []*GattCharacteristic
+}
+
+var _ bluetooth.GattService = (*GattService)(nil)
+
+func (s *GattService) GetID() string {
+	return s.ID
+}
+
+func (s *GattService) GetUUID() string {
+	return s.UUID
+}
+
+func (s *GattService) GetPrimary() bool {
+	return s.Primary
+}
+
+func (s *GattService) GetCharacteristics() []bluetooth.GattCharacteristic {
+	chars := make([]bluetooth.GattCharacteristic, 0, len(s.Characteristics))
+	for _, c := range s.Characteristics {
+		chars = append(chars, c)
+	}
+
+	return chars
+}
+
+// GattDescriptor is an in-memory GATT descriptor.
+type GattDescriptor struct {
+	ID    string
+	UUID  string
+	Flags []string
+}
+
+var _ bluetooth.GattDescriptor = (*GattDescriptor)(nil)
+
+func (d *GattDescriptor) GetID() string {
+	return d.ID
+}
+
+func (d *GattDescriptor) GetUUID() string {
+	return d.UUID
+}
+
+func (d *GattDescriptor) GetFlags() []string {
+	return d.Flags
+}
+
+// GattCharacteristic is a scriptable in-memory GATT characteristic. It does not check its flags, that is
+// up to the server.
+type GattCharacteristic struct {
+	ID          string
+	UUID        string
+	Flags       []string
+	Descriptors []*GattDescriptor
+
+	mu          sync.Mutex
+	value       []byte
+	err         error
+	subscribers map[chan []byte]struct{}
+}
+
+var _ bluetooth.GattCharacteristic = (*GattCharacteristic)(nil)
+
+// SetValue changes the value and notifies it to all subscribers.
+func (c *GattCharacteristic) SetValue(value []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.value = value
+	for ch := range c.subscribers {
+		select {
+		case ch <- value:
+		default:
+		}
+	}
+}
+
+// Value returns the last value that was set or written.
+func (c *GattCharacteristic) Value() []byte {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.value
+}
+
+// Fail makes reads, writes and Notify return err until called again with nil.
+func (c *GattCharacteristic) Fail(err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.err = err
+}
+
+// Subscribers returns the number of active notification subscriptions.
+func (c *GattCharacteristic) Subscribers() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return len(c.subscribers)
+}
+
+func (c *GattCharacteristic) GetID() string {
+	return c.ID
+}
+
+func (c *GattCharacteristic) GetUUID() string {
+	return c.UUID
+}
+
+func (c *GattCharacteristic) GetFlags() []string {
+	return c.Flags
+}
+
+func (c *GattCharacteristic) GetDescriptors() []bluetooth.GattDescriptor {
+	descs := make([]bluetooth.GattDescriptor, 0, len(c.Descriptors))
+	for _, d := range c.Descriptors {
+		descs = append(descs, d)
+	}
+
+	return descs
+}
+
+func (c *GattCharacteristic) ReadValue(ctx context.Context) ([]byte, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	return c.value, ctx.Err()
+}
+
+// WriteValue stores the value without notifying it, like a device that does not echo writes.
+func (c *GattCharacteristic) WriteValue(ctx context.Context, value []byte, _ bool) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.err != nil {
+		return c.err
+	}
+	c.value = value
+
+	return ctx.Err()
+}
+
+func (c *GattCharacteristic) Notify(ctx context.Context) (<-chan []byte, func(), error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := ctx.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	if c.err != nil {
+		return nil, nil, c.err
+	}
+
+	ch := make(chan []byte, 10)
+	if c.subscribers == nil {
+		c.subscribers = make(map[chan []byte]struct{})
+	}
+	c.subscribers[ch] = struct{}{}
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			c.mu.Lock()
+			defer c.mu.Unlock()
+
+			delete(c.subscribers, ch)
+			close(ch)
+		})
+	}, nil
+}
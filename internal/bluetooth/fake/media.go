@@ -0,0 +1,277 @@
+package fake
+
+import (
+	"sync"
+
+	"github.com/andree-bjorkgard/remote-bluetooth/internal/bluetooth"
+)
+
+// volumeStep is how much VolumeUp and VolumeDown change the volume, out of the 127 AVRCP allows
+const volumeStep = 8
+
+// PlayerProperties are the media player properties the fake exposes.
+type PlayerProperties struct {
+	Name     string
+	Status   string
+	Track    bluetooth.Track
+	Position uint32
+	// Volume is between 0 and 127
+	Volume uint8
+}
+
+// MediaPlayer is a scriptable in-memory media player.
+type MediaPlayer struct {
+	mu sync.Mutex
+
+	props    PlayerProperties
+	err      error
+	watchers watchers
+}
+
+var _ bluetooth.MediaPlayer = (*MediaPlayer)(nil)
+
+func NewMediaPlayer(props PlayerProperties) *MediaPlayer {
+	if props.Status == "" {
+		props.Status = "stopped"
+	}
+
+	return &MediaPlayer{props: props}
+}
+
+// Update changes the player properties and notifies all watchers.
+func (p *MediaPlayer) Update(fn func(p *PlayerProperties)) {
+	p.mu.Lock()
+	fn(&p.props)
+	p.mu.Unlock()
+
+	p.watchers.notify()
+}
+
+// Fail makes every control return err until called again with nil.
+func (p *MediaPlayer) Fail(err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.err = err
+}
+
+func (p *MediaPlayer) PlayerProperties() PlayerProperties {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.props
+}
+
+func (p *MediaPlayer) GetName() (string, error) {
+	return p.PlayerProperties().Name, nil
+}
+
+func (p *MediaPlayer) GetStatus() (string, error) {
+	return p.PlayerProperties().Status, nil
+}
+
+func (p *MediaPlayer) GetTrack() (bluetooth.Track, error) {
+	return p.PlayerProperties().Track, nil
+}
+
+func (p *MediaPlayer) GetPosition() (uint32, error) {
+	return p.PlayerProperties().Position, nil
+}
+
+func (p *MediaPlayer) Play() error {
+	return p.control(func(props *PlayerProperties) { props.Status = "playing" })
+}
+
+func (p *MediaPlayer) Pause() error {
+	return p.control(func(props *PlayerProperties) { props.Status = "paused" })
+}
+
+// Next moves to the next track number and starts it from the beginning.
+func (p *MediaPlayer) Next() error {
+	return p.control(func(props *PlayerProperties) {
+		props.Track.TrackNumber++
+		props.Position = 0
+	})
+}
+
+// Previous moves to the previous track number, if there is one, and starts it from the beginning.
+func (p *MediaPlayer) Previous() error {
+	return p.control(func(props *PlayerProperties) {
+		if props.Track.TrackNumber > 1 {
+			props.Track.TrackNumber--
+		}
+		props.Position = 0
+	})
+}
+
+func (p *MediaPlayer) VolumeUp() error {
+	return p.control(func(props *PlayerProperties) {
+		props.Volume = min(props.Volume+volumeStep, 127)
+	})
+}
+
+func (p *MediaPlayer) VolumeDown() error {
+	return p.control(func(props *PlayerProperties) {
+		props.Volume -= min(props.Volume, volumeStep)
+	})
+}
+
+func (p *MediaPlayer) control(fn func(props *PlayerProperties)) error {
+	p.mu.Lock()
+	err := p.err
+	if err == nil {
+		fn(&p.props)
+	}
+	p.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	p.watchers.notify()
+
+	return nil
+}
+
+func (p *MediaPlayer) WatchChanges() (<-chan struct{}, func(), error) {
+	return p.watchers.watch()
+}
+
+// TransportProperties are the media transport properties the fake exposes.
+type TransportProperties struct {
+	ID    string
+	UUID  string
+	Codec byte
+	State string
+	// Volume is between 0 and 127, nil if the transport has no volume
+	Volume *uint16
+}
+
+// MediaTransport is a scriptable in-memory media transport.
+type MediaTransport struct {
+	mu sync.Mutex
+
+	props    TransportProperties
+	err      error
+	watchers watchers
+}
+
+var _ bluetooth.MediaTransport = (*MediaTransport)(nil)
+
+func NewMediaTransport(props TransportProperties) *MediaTransport {
+	if props.State == "" {
+		props.State = "idle"
+	}
+
+	return &MediaTransport{props: props}
+}
+
+// Update changes the transport properties and notifies all watchers.
+func (t *MediaTransport) Update(fn func(p *TransportProperties)) {
+	t.mu.Lock()
+	fn(&t.props)
+	t.mu.Unlock()
+
+	t.watchers.notify()
+}
+
+// FailSetVolume makes SetVolume return err until called again with nil.
+func (t *MediaTransport) FailSetVolume(err error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.err = err
+}
+
+func (t *MediaTransport) TransportProperties() TransportProperties {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.props
+}
+
+func (t *MediaTransport) GetID() string {
+	return t.TransportProperties().ID
+}
+
+func (t *MediaTransport) GetUUID() (string, error) {
+	return t.TransportProperties().UUID, nil
+}
+
+func (t *MediaTransport) GetCodec() (byte, error) {
+	return t.TransportProperties().Codec, nil
+}
+
+func (t *MediaTransport) GetState() (string, error) {
+	return t.TransportProperties().State, nil
+}
+
+func (t *MediaTransport) GetVolume() (uint16, error) {
+	p := t.TransportProperties()
+	if p.Volume == nil {
+		return 0, bluetooth.ErrVolumeNotSupported
+	}
+
+	return *p.Volume, nil
+}
+
+func (t *MediaTransport) SetVolume(volume uint16) error {
+	if volume > bluetooth.MaxVolume {
+		return bluetooth.ErrInvalidVolume
+	}
+
+	t.mu.Lock()
+	err := t.err
+	if err == nil && t.props.Volume == nil {
+		err = bluetooth.ErrVolumeNotSupported
+	}
+	if err == nil {
+		t.props.Volume = &volume
+	}
+	t.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	t.watchers.notify()
+
+	return nil
+}
+
+func (t *MediaTransport) WatchChanges() (<-chan struct{}, func(), error) {
+	return t.watchers.watch()
+}
+
+// watchers are the subscriptions of a fake that signals its changes
+type watchers struct {
+	mu    sync.Mutex
+	chans map[chan struct{}]struct{}
+}
+
+func (w *watchers) watch() (<-chan struct{}, func(), error) {
+	ch := make(chan struct{}, 1)
+
+	w.mu.Lock()
+	if w.chans == nil {
+		w.chans = make(map[chan struct{}]struct{})
+	}
+	w.chans[ch] = struct{}{}
+	w.mu.Unlock()
+
+	return ch, func() {
+		w.mu.Lock()
+		delete(w.chans, ch)
+		w.mu.Unlock()
+	}, nil
+}
+
+func (w *watchers) notify() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	for ch := range w.chans {
+		select {
+		case ch <- struct{}{}:
+		default:
+		}
+	}
+}
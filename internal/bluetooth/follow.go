@@ -0,0 +1,198 @@
+package bluetooth
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sync"
+
+	"github.com/muka/go-bluetooth/bluez/profile/adapter"
+	"github.com/muka/go-bluetooth/bluez/profile/device"
+)
+
+// WatchDevices follows the InterfacesAdded and InterfacesRemoved signals of the devices of the adapter
+func (a *bluezAdapter) WatchDevices() (<-chan Device, <-chan string, func(), error) {
+	events, cancel, err := a.adapter.OnDeviceDiscovered()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	added := make(chan Device)
+	removed := make(chan string)
+	done := make(chan struct{})
+	stopped := make(chan struct{})
+
+	go func() {
+		defer close(stopped)
+		for {
+			var ev *adapter.DeviceDiscovered
+			select {
+			case <-done:
+				return
+			case ev = <-events:
+			}
+			if ev == nil {
+				return
+			}
+			if !isDevicePath(a.adapter.Path(), ev.Path) {
+				continue
+			}
+
+			if ev.Type == adapter.DeviceRemoved {
+				select {
+				case removed <- addressFromPath(ev.Path):
+				case <-done:
+					return
+				}
+				continue
+			}
+
+			d, err := device.NewDevice1(ev.Path)
+			if err != nil {
+				log.Println("Error loading added device:", err)
+				continue
+			}
+			select {
+			case added <- &bluezDevice{d}:
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	var once sync.Once
+	stop := func() {
+		once.Do(func() {
+			close(done)
+			<-stopped
+			cancel()
+		})
+	}
+
+	return added, removed, stop, nil
+}
+
+// followDevices sends every device of every adapter whenever it changes, and once when it is added, until
+// ctx is done. The adapters are followed too, so the devices of adapters that are plugged in later or bound
+// again after the Bluetooth service restarted are sent as well.
+func (s *BluetoothServer) followDevices(ctx context.Context) <-chan Device {
+	updates := make(chan Device, 10)
+	changes, unsubscribe := s.adapters.changes.subscribe()
+
+	go func() {
+		defer unsubscribe()
+
+		// Keyed by the managed adapter rather than the id, since binding again creates new ones
+		following := make(map[*managedAdapter]context.CancelFunc)
+		defer func() {
+			for _, cancel := range following {
+				cancel()
+			}
+		}()
+
+		refresh := func() {
+			// Without the service there are no adapters, which stops following all of them
+			adapters, _ := s.adapters.list("")
+
+			current := make(map[*managedAdapter]bool, len(adapters))
+			for _, a := range adapters {
+				current[a] = true
+				if _, ok := following[a]; ok {
+					continue
+				}
+
+				actx, cancel := context.WithCancel(ctx)
+				following[a] = cancel
+				go followAdapterDevices(actx, a, updates)
+			}
+			for a, cancel := range following {
+				if !current[a] {
+					cancel()
+					delete(following, a)
+				}
+			}
+		}
+
+		refresh()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-changes:
+				refresh()
+			}
+		}
+	}()
+
+	return updates
+}
+
+// followAdapterDevices follows the changes of every device of the adapter until ctx is done
+func followAdapterDevices(ctx context.Context, a Adapter, updates chan<- Device) {
+	added, removed, stop, err := a.WatchDevices()
+	if err != nil {
+		log.Println("Error watching devices of adapter", a.GetID()+":", err)
+		return
+	}
+	defer stop()
+
+	following := make(map[string]context.CancelFunc)
+	defer func() {
+		for _, cancel := range following {
+			cancel()
+		}
+	}()
+
+	follow := func(d Device) {
+		addr, _ := d.GetAddress()
+		if cancel, ok := following[addr]; ok {
+			cancel()
+		}
+
+		changes, stopChanges, err := d.WatchChanges()
+		if err != nil {
+			log.Println("Error watching device", addr+":", err)
+			return
+		}
+
+		dctx, cancel := context.WithCancel(ctx)
+		following[addr] = cancel
+		go func() {
+			defer stopChanges()
+			forwardChanges(dctx, d, changes, updates)
+		}()
+	}
+
+	devs, err := a.GetDevices()
+	if err != nil && !errors.Is(err, ErrBluetoothUnavailable) {
+		log.Println("Error getting devices of adapter", a.GetID()+":", err)
+	}
+	for _, d := range devs {
+		follow(d)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case d, ok := <-added:
+			if !ok {
+				return
+			}
+			follow(d)
+			select {
+			case updates <- d:
+			case <-ctx.Done():
+				return
+			}
+		case addr, ok := <-removed:
+			if !ok {
+				return
+			}
+			if cancel, ok := following[addr]; ok {
+				cancel()
+				delete(following, addr)
+			}
+		}
+	}
+}
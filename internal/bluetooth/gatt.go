@@ -0,0 +1,323 @@
+package bluetooth
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/godbus/dbus/v5"
+	"github.com/muka/go-bluetooth/bluez"
+	"github.com/muka/go-bluetooth/bluez/profile/gatt"
+)
+
+var (
+	ErrCharacteristicNotFound = errors.New("characteristic not found")
+	// ErrAmbiguousCharacteristic is returned for a UUID several characteristics have, they need their id
+	ErrAmbiguousCharacteristic = errors.New("several characteristics have the UUID")
+	ErrNotPermitted            = errors.New("operation not permitted by the characteristic")
+)
+
+// GattService is a primary or included service of a device, BlueZ only has them while the device is
+// connected and its services are resolved.
+type GattService interface {
+	// GetID returns the path of the service below the device, e.g. service000a
+	GetID() string
+	GetUUID() string
+	GetPrimary() bool
+	GetCharacteristics() []GattCharacteristic
+}
+
+// GattCharacteristic is a value of a service
+type GattCharacteristic interface {
+	// GetID returns the path of the characteristic below the device, e.g. service000a/char000b
+	GetID() string
+	GetUUID() string
+	// GetFlags returns what the characteristic allows, e.g. read, write, write-without-response and notify
+	GetFlags() []string
+	GetDescriptors() []GattDescriptor
+
+	ReadValue(ctx context.Context) ([]byte, error)
+	// WriteValue writes without waiting for the device to confirm when withoutResponse is set
+	WriteValue(ctx context.Context, value []byte, withoutResponse bool) error
+	// Notify sends every value the device notifies or indicates on the returned channel, until stop is
+	// called, which closes the channel. ctx bounds starting the notifications.
+	Notify(ctx context.Context) (values <-chan []byte, stop func(), err error)
+}
+
+// GattDescriptor describes a characteristic, e.g. its user description or presentation format
+type GattDescriptor interface {
+	// GetID returns the path of the descriptor below the device, e.g. service000a/char000b/desc000d
+	GetID() string
+	GetUUID() string
+	GetFlags() []string
+}
+
+// GetGattServices builds the services of the device from the GATT objects below it, sorted by id
+func (d *bluezDevice) GetGattServices() ([]GattService, error) {
+	om, err := bluez.GetObjectManager()
+	if err != nil {
+		return nil, err
+	}
+	objects, err := om.GetManagedObjects()
+	if err != nil {
+		return nil, err
+	}
+
+	prefix := string(d.Path()) + "/"
+	var paths []string
+	for p := range objects {
+		if strings.HasPrefix(string(p), prefix) {
+			paths = append(paths, string(p))
+		}
+	}
+	// Parents sort before their children, so every service and characteristic exists before it is used
+	sort.Strings(paths)
+
+	var services []GattService
+	byPath := make(map[string]*bluezGattService)
+	chars := make(map[string]*bluezGattCharacteristic)
+	for _, p := range paths {
+		ifaces := objects[dbus.ObjectPath(p)]
+		id := strings.TrimPrefix(p, prefix)
+
+		if props, ok := ifaces[gatt.GattService1Interface]; ok {
+			s := &bluezGattService{id: id, uuid: stringProp(props, "UUID")}
+			s.primary, _ = props["Primary"].Value().(bool)
+			byPath[p] = s
+			services = append(services, s)
+		}
+		if props, ok := ifaces[gatt.GattCharacteristic1Interface]; ok {
+			service, _ := props["Service"].Value().(dbus.ObjectPath)
+			s, ok := byPath[string(service)]
+			if !ok {
+				continue
+			}
+			flags, _ := props["Flags"].Value().([]string)
+			c := &bluezGattCharacteristic{
+				id:     id,
+				path:   dbus.ObjectPath(p),
+				uuid:   stringProp(props, "UUID"),
+				flags:  flags,
+				client: bluez.NewClient(&bluez.Config{Name: bluez.OrgBluezInterface, Iface: gatt.GattCharacteristic1Interface, Path: dbus.ObjectPath(p), Bus: bluez.SystemBus}),
+			}
+			chars[p] = c
+			s.characteristics = append(s.characteristics, c)
+		}
+		if props, ok := ifaces[gatt.GattDescriptor1Interface]; ok {
+			char, _ := props["Characteristic"].Value().(dbus.ObjectPath)
+			c, ok := chars[string(char)]
+			if !ok {
+				continue
+			}
+			flags, _ := props["Flags"].Value().([]string)
+			c.descriptors = append(c.descriptors, &bluezGattDescriptor{id: id, uuid: stringProp(props, "UUID"), flags: flags})
+		}
+	}
+
+	return services, nil
+}
+
+func stringProp(props map[string]dbus.Variant, name string) string {
+	s, _ := props[name].Value().(string)
+	return strings.ToLower(s)
+}
+
+type bluezGattService struct {
+	id              string
+	uuid            string
+	primary         bool
+	characteristics []GattCharacteristic
+}
+
+func (s *bluezGattService) GetID() string {
+	return s.id
+}
+
+func (s *bluezGattService) GetUUID() string {
+	return s.uuid
+}
+
+func (s *bluezGattService) GetPrimary() bool {
+	return s.primary
+}
+
+func (s *bluezGattService) GetCharacteristics() []GattCharacteristic {
+	return s.characteristics
+}
+
+type bluezGattDescriptor struct {
+	id    string
+	uuid  string
+	flags []string
+}
+
+func (d *bluezGattDescriptor) GetID() string {
+	return d.id
+}
+
+func (d *bluezGattDescriptor) GetUUID() string {
+	return d.uuid
+}
+
+func (d *bluezGattDescriptor) GetFlags() []string {
+	return d.flags
+}
+
+type bluezGattCharacteristic struct {
+	id          string
+	path        dbus.ObjectPath
+	uuid        string
+	flags       []string
+	descriptors []GattDescriptor
+	client      *bluez.Client
+}
+
+var _ GattCharacteristic = (*bluezGattCharacteristic)(nil)
+
+func (c *bluezGattCharacteristic) GetID() string {
+	return c.id
+}
+
+func (c *bluezGattCharacteristic) GetUUID() string {
+	return c.uuid
+}
+
+func (c *bluezGattCharacteristic) GetFlags() []string {
+	return c.flags
+}
+
+func (c *bluezGattCharacteristic) GetDescriptors() []GattDescriptor {
+	return c.descriptors
+}
+
+func (c *bluezGattCharacteristic) ReadValue(ctx context.Context) ([]byte, error) {
+	var value []byte
+	err := c.call(ctx, "ReadValue", map[string]interface{}{}).Store(&value)
+
+	return value, err
+}
+
+func (c *bluezGattCharacteristic) WriteValue(ctx context.Context, value []byte, withoutResponse bool) error {
+	writeType := "request"
+	if withoutResponse {
+		writeType = "command"
+	}
+
+	return c.call(ctx, "WriteValue", value, map[string]interface{}{"type": writeType}).Store()
+}
+
+func (c *bluezGattCharacteristic) call(ctx context.Context, method string, args ...interface{}) *dbus.Call {
+	obj := c.client.GetDbusObject()
+	if obj == nil {
+		if err := c.client.Connect(); err != nil {
+			return &dbus.Call{Err: err}
+		}
+		obj = c.client.GetDbusObject()
+	}
+
+	return obj.CallWithContext(ctx, gatt.GattCharacteristic1Interface+"."+method, 0, args...)
+}
+
+// BlueZ keeps a single notify session per characteristic and D-Bus connection, so the first subscriber
+// starts it and the last one stops it. The sessions are kept while anyone uses or waits for them.
+var notifySessions = struct {
+	sync.Mutex
+	sessions map[dbus.ObjectPath]*notifySession
+}{sessions: make(map[dbus.ObjectPath]*notifySession)}
+
+type notifySession struct {
+	// refs is guarded by notifySessions
+	refs int
+
+	// mu is held while starting and stopping, so subscribers of other characteristics are not held up
+	mu          sync.Mutex
+	subscribers int
+}
+
+func acquireNotifySession(path dbus.ObjectPath) *notifySession {
+	notifySessions.Lock()
+	defer notifySessions.Unlock()
+
+	ns, ok := notifySessions.sessions[path]
+	if !ok {
+		ns = &notifySession{}
+		notifySessions.sessions[path] = ns
+	}
+	ns.refs++
+
+	return ns
+}
+
+func releaseNotifySession(path dbus.ObjectPath, ns *notifySession) {
+	notifySessions.Lock()
+	defer notifySessions.Unlock()
+
+	ns.refs--
+	if ns.refs == 0 {
+		delete(notifySessions.sessions, path)
+	}
+}
+
+// Notify reads the notified values from the PropertiesChanged signals of the Value property
+func (c *bluezGattCharacteristic) Notify(ctx context.Context) (<-chan []byte, func(), error) {
+	changed, unsubscribe, err := watchPropertiesChanged(c.path, gatt.GattCharacteristic1Interface)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ns := acquireNotifySession(c.path)
+	ns.mu.Lock()
+	if ns.subscribers == 0 {
+		if err := c.call(ctx, "StartNotify").Store(); err != nil {
+			ns.mu.Unlock()
+			releaseNotifySession(c.path, ns)
+			unsubscribe()
+			return nil, nil, err
+		}
+	}
+	ns.subscribers++
+	ns.mu.Unlock()
+
+	values := make(chan []byte, 10)
+	done := make(chan struct{})
+
+	go func() {
+		defer close(values)
+		for props := range changed {
+			value, ok := props["Value"].Value().([]byte)
+			if !ok {
+				continue
+			}
+
+			select {
+			case values <- value:
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	var once sync.Once
+	stop := func() {
+		once.Do(func() {
+			close(done)
+			unsubscribe()
+
+			ns.mu.Lock()
+			ns.subscribers--
+			if ns.subscribers == 0 {
+				// The subscriber is usually gone already, so the stop is not bound by its context
+				if err := c.call(context.Background(), "StopNotify").Store(); err != nil {
+					log.Println("Error stopping notifications:", err)
+				}
+			}
+			ns.mu.Unlock()
+			releaseNotifySession(c.path, ns)
+		})
+	}
+
+	return values, stop, nil
+}
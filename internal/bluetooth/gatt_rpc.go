@@ -0,0 +1,175 @@
+package bluetooth
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	btgrpc "github.com/andree-bjorkgard/remote-bluetooth/internal/bluetooth/grpc"
+)
+
+// Characteristic flags that allow each kind of access, one of them is enough
+var (
+	readFlags    = []string{"read", "encrypt-read", "encrypt-authenticated-read", "secure-read"}
+	writeFlags   = []string{"write", "encrypt-write", "encrypt-authenticated-write", "secure-write", "reliable-write", "authenticated-signed-writes"}
+	commandFlags = []string{"write-without-response", "authenticated-signed-writes"}
+	notifyFlags  = []string{"notify", "indicate", "encrypt-notify", "encrypt-authenticated-notify", "secure-notify"}
+)
+
+func (s *BluetoothServer) ListGattServices(ctx context.Context, request *btgrpc.DeviceRequest) (*btgrpc.GattServices, error) {
+	dev, err := s.allowedDevice(ctx, "ListGattServices", request.AdapterId, request.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	services, err := dev.GetGattServices()
+	if err != nil {
+		return nil, deviceError(err, request.Address)
+	}
+
+	resp := &btgrpc.GattServices{}
+	for _, svc := range services {
+		gs := &btgrpc.GattService{
+			Id:      svc.GetID(),
+			Profile: &btgrpc.Profile{Uuid: svc.GetUUID(), Name: profileName(svc.GetUUID())},
+			Primary: svc.GetPrimary(),
+		}
+		for _, c := range svc.GetCharacteristics() {
+			gc := &btgrpc.GattCharacteristic{Id: c.GetID(), Uuid: c.GetUUID(), Flags: c.GetFlags()}
+			for _, d := range c.GetDescriptors() {
+				gc.Descriptors = append(gc.Descriptors, &btgrpc.GattDescriptor{Id: d.GetID(), Uuid: d.GetUUID(), Flags: d.GetFlags()})
+			}
+			gs.Characteristics = append(gs.Characteristics, gc)
+		}
+		resp.Services = append(resp.Services, gs)
+	}
+
+	return resp, nil
+}
+
+func (s *BluetoothServer) ReadCharacteristic(ctx context.Context, request *btgrpc.CharacteristicRequest) (*btgrpc.CharacteristicValue, error) {
+	c, _, err := s.characteristic(ctx, "ReadCharacteristic", request.AdapterId, request.Address, request.Characteristic, readFlags)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := c.ReadValue(ctx)
+	if err != nil {
+		return nil, deviceError(err, request.Address)
+	}
+
+	return &btgrpc.CharacteristicValue{Address: request.Address, CharacteristicId: c.GetID(), Value: value}, nil
+}
+
+func (s *BluetoothServer) WriteCharacteristic(ctx context.Context, request *btgrpc.WriteCharacteristicRequest) (*btgrpc.Response, error) {
+	resp := &btgrpc.Response{Success: false}
+	flags := writeFlags
+	if request.WithoutResponse {
+		flags = commandFlags
+	}
+
+	c, _, err := s.characteristic(ctx, "WriteCharacteristic", request.AdapterId, request.Address, request.Characteristic, flags)
+	if err != nil {
+		return resp, err
+	}
+	if err := c.WriteValue(ctx, request.Value, request.WithoutResponse); err != nil {
+		return resp, deviceError(err, request.Address)
+	}
+
+	resp.Success = true
+	return resp, nil
+}
+
+// WatchCharacteristic ends when the device disconnects, since BlueZ ends the notifications without
+// telling the subscribers
+func (s *BluetoothServer) WatchCharacteristic(request *btgrpc.CharacteristicRequest, stream btgrpc.Bluetooth_WatchCharacteristicServer) error {
+	ctx := stream.Context()
+	c, dev, err := s.characteristic(ctx, "WatchCharacteristic", request.AdapterId, request.Address, request.Characteristic, notifyFlags)
+	if err != nil {
+		return err
+	}
+
+	devChanges, stopDev, err := dev.WatchChanges()
+	if err != nil {
+		return deviceError(err, request.Address)
+	}
+	defer stopDev()
+
+	values, stop, err := c.Notify(ctx)
+	if err != nil {
+		return deviceError(err, request.Address)
+	}
+	defer stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-devChanges:
+			if connected, _ := dev.GetConnected(); !connected {
+				return nil
+			}
+		case v, ok := <-values:
+			if !ok {
+				return nil
+			}
+			if err := stream.Send(&btgrpc.CharacteristicValue{Address: request.Address, CharacteristicId: c.GetID(), Value: v}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// characteristic finds the characteristic of a connected device the client may access by its id, or by its UUID
+// if no other characteristic has it, and checks that it has one of the flags
+func (s *BluetoothServer) characteristic(ctx context.Context, operation, adapterID, address, ref string, flags []string) (GattCharacteristic, Device, error) {
+	dev, err := s.allowedDevice(ctx, operation, adapterID, address)
+	if err != nil {
+		return nil, nil, err
+	}
+	if connected, _ := dev.GetConnected(); !connected {
+		return nil, nil, deviceError(ErrNotConnected, address)
+	}
+
+	services, err := dev.GetGattServices()
+	if err != nil {
+		return nil, nil, deviceError(err, address)
+	}
+
+	uuid, isUUID := parseUUID(strings.ToLower(strings.TrimSpace(ref)))
+	var byID GattCharacteristic
+	var byUUID []GattCharacteristic
+	for _, svc := range services {
+		for _, c := range svc.GetCharacteristics() {
+			if c.GetID() == ref {
+				byID = c
+			} else if isUUID && c.GetUUID() == uuid {
+				byUUID = append(byUUID, c)
+			}
+		}
+	}
+
+	c := byID
+	if c == nil {
+		switch len(byUUID) {
+		case 0:
+			return nil, nil, deviceError(fmt.Errorf("%w: %s", ErrCharacteristicNotFound, ref), address)
+		case 1:
+			c = byUUID[0]
+		default:
+			ids := make([]string, len(byUUID))
+			for i, c := range byUUID {
+				ids[i] = c.GetID()
+			}
+			return nil, nil, deviceError(fmt.Errorf("%w: %s is %s", ErrAmbiguousCharacteristic, ref, strings.Join(ids, ", ")), address)
+		}
+	}
+
+	for _, f := range c.GetFlags() {
+		if contains(flags, f) {
+			return c, dev, nil
+		}
+	}
+
+	return nil, nil, deviceError(fmt.Errorf("%w: %s of %s", ErrNotPermitted, flags[0], c.GetID()), address)
+}
@@ -0,0 +1,100 @@
+package bluetooth_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/andree-bjorkgard/remote-bluetooth/internal/bluetooth"
+	"github.com/andree-bjorkgard/remote-bluetooth/internal/bluetooth/fake"
+)
+
+const (
+	batteryLevelUUID = "00002a19-0000-1000-8000-00805f9b34fb"
+	customUUID       = "6e400003-b5a3-f393-e0a9-e50e24dcca9e"
+)
+
+// newTestGattServices gives the device a battery level and two characteristics sharing a UUID
+func newTestGattServices(d *fake.Device) (*fake.GattCharacteristic, *fake.GattCharacteristic) {
+	level := &fake.GattCharacteristic{ID: "service0010/char0011", UUID: batteryLevelUUID, Flags: []string{"read", "notify"}}
+	level.SetValue([]byte{80})
+	first := &fake.GattCharacteristic{ID: "service0020/char0021", UUID: customUUID, Flags: []string{"read", "write"}}
+	second := &fake.GattCharacteristic{ID: "service0020/char0024", UUID: customUUID, Flags: []string{"read", "write"}}
+
+	d.SetGattServices(
+		&fake.GattService{ID: "service0010", UUID: bluetooth.BATTERY_UUID, Primary: true, Characteristics: []*fake.GattCharacteristic{level}},
+		&fake.GattService{ID: "service0020", UUID: "6e400001-b5a3-f393-e0a9-e50e24dcca9e", Primary: true, Characteristics: []*fake.GattCharacteristic{first, second}},
+	)
+
+	return level, second
+}
+
+func TestCharacteristicLookup(t *testing.T) {
+	headphones, _ := newTestDevices()
+	level, second := newTestGattServices(headphones)
+	lis := testServer(t, fake.NewAdapters(fake.NewAdapter(headphones)), "")
+	bc := testClient(t, lis, testSecret)
+	ctx := context.Background()
+
+	for _, ref := range []string{"2a19", batteryLevelUUID, level.ID} {
+		v, err := bc.ReadCharacteristic(ctx, "AA:AA:AA:AA:AA:01", ref)
+		if err != nil {
+			t.Fatalf("reading %s: %v", ref, err)
+		}
+		if len(v) != 1 || v[0] != 80 {
+			t.Fatalf("reading %s = %v, want [80]", ref, v)
+		}
+	}
+
+	// A UUID several characteristics have needs the id instead
+	_, err := bc.ReadCharacteristic(ctx, "AA:AA:AA:AA:AA:01", customUUID)
+	assertStatus(t, err, codes.InvalidArgument, bluetooth.ReasonAmbiguousUUID)
+	if err := bc.WriteCharacteristic(ctx, "AA:AA:AA:AA:AA:01", second.ID, []byte{1}); err != nil {
+		t.Fatal(err)
+	}
+	if v := second.Value(); len(v) != 1 || v[0] != 1 {
+		t.Fatalf("value = %v, want [1]", v)
+	}
+
+	_, err = bc.ReadCharacteristic(ctx, "AA:AA:AA:AA:AA:01", "2a00")
+	assertStatus(t, err, codes.NotFound, bluetooth.ReasonCharacteristicNotFound)
+	err = bc.WriteCharacteristic(ctx, "AA:AA:AA:AA:AA:01", level.ID, []byte{1})
+	assertStatus(t, err, codes.FailedPrecondition, bluetooth.ReasonNotPermitted)
+}
+
+func TestGattACL(t *testing.T) {
+	headphones, _ := newTestDevices()
+	level, _ := newTestGattServices(headphones)
+	lis := testServer(t, fake.NewAdapters(fake.NewAdapter(headphones)), `{
+		"default": "allow",
+		"rules": [{"devices": ["Headphones"], "operations": ["*Gatt*", "*Characteristic"], "action": "deny"}]
+	}`)
+	bc := testClient(t, lis, testSecret)
+	ctx := context.Background()
+
+	_, err := bc.ListGattServices(ctx, "AA:AA:AA:AA:AA:01")
+	assertStatus(t, err, codes.PermissionDenied, bluetooth.ReasonPermissionDenied)
+	_, err = bc.ReadCharacteristic(ctx, "AA:AA:AA:AA:AA:01", level.ID)
+	assertStatus(t, err, codes.PermissionDenied, bluetooth.ReasonPermissionDenied)
+	err = bc.WriteCharacteristic(ctx, "AA:AA:AA:AA:AA:01", level.ID, []byte{1})
+	assertStatus(t, err, codes.PermissionDenied, bluetooth.ReasonPermissionDenied)
+
+	ch, err := bc.WatchCharacteristic(ctx, "AA:AA:AA:AA:AA:01", level.ID)
+	if err != nil {
+		assertStatus(t, err, codes.PermissionDenied, bluetooth.ReasonPermissionDenied)
+		return
+	}
+	select {
+	case v, ok := <-ch:
+		if ok {
+			t.Fatalf("got value %v of a denied device", v.Value)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("watching a denied device did not end")
+	}
+	if n := level.Subscribers(); n != 0 {
+		t.Fatalf("%d subscribers of a denied device", n)
+	}
+}
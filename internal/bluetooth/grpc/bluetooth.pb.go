@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.28.1
+// 	protoc-gen-go v1.32.0
 // 	protoc        v4.25.2
 // source: proto/bluetooth.proto
 
@@ -20,18 +20,369 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ListDevicesRequest_SortBy int32
+
+const (
+	ListDevicesRequest_NAME    ListDevicesRequest_SortBy = 0
+	ListDevicesRequest_ADDRESS ListDevicesRequest_SortBy = 1
+	// Connected devices first
+	ListDevicesRequest_CONNECTED ListDevicesRequest_SortBy = 2
+	// Lowest battery first, devices without a known battery level last
+	ListDevicesRequest_BATTERY ListDevicesRequest_SortBy = 3
+)
+
+// Enum value maps for ListDevicesRequest_SortBy.
+var (
+	ListDevicesRequest_SortBy_name = map[int32]string{
+		0: "NAME",
+		1: "ADDRESS",
+		2: "CONNECTED",
+		3: "BATTERY",
+	}
+	ListDevicesRequest_SortBy_value = map[string]int32{
+		"NAME":      0,
+		"ADDRESS":   1,
+		"CONNECTED": 2,
+		"BATTERY":   3,
+	}
+)
+
+func (x ListDevicesRequest_SortBy) Enum() *ListDevicesRequest_SortBy {
+	p := new(ListDevicesRequest_SortBy)
+	*p = x
+	return p
+}
+
+func (x ListDevicesRequest_SortBy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ListDevicesRequest_SortBy) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_bluetooth_proto_enumTypes[0].Descriptor()
+}
+
+func (ListDevicesRequest_SortBy) Type() protoreflect.EnumType {
+	return &file_proto_bluetooth_proto_enumTypes[0]
+}
+
+func (x ListDevicesRequest_SortBy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ListDevicesRequest_SortBy.Descriptor instead.
+func (ListDevicesRequest_SortBy) EnumDescriptor() ([]byte, []int) {
+	return file_proto_bluetooth_proto_rawDescGZIP(), []int{11, 0}
+}
+
+type AgentRequest_Type int32
+
+const (
+	AgentRequest_REQUEST_PIN_CODE      AgentRequest_Type = 0
+	AgentRequest_DISPLAY_PIN_CODE      AgentRequest_Type = 1
+	AgentRequest_REQUEST_PASSKEY       AgentRequest_Type = 2
+	AgentRequest_DISPLAY_PASSKEY       AgentRequest_Type = 3
+	AgentRequest_REQUEST_CONFIRMATION  AgentRequest_Type = 4
+	AgentRequest_REQUEST_AUTHORIZATION AgentRequest_Type = 5
+	AgentRequest_AUTHORIZE_SERVICE     AgentRequest_Type = 6
+	// The request with the same id was cancelled and must no longer be answered
+	AgentRequest_CANCEL AgentRequest_Type = 7
+)
+
+// Enum value maps for AgentRequest_Type.
+var (
+	AgentRequest_Type_name = map[int32]string{
+		0: "REQUEST_PIN_CODE",
+		1: "DISPLAY_PIN_CODE",
+		2: "REQUEST_PASSKEY",
+		3: "DISPLAY_PASSKEY",
+		4: "REQUEST_CONFIRMATION",
+		5: "REQUEST_AUTHORIZATION",
+		6: "AUTHORIZE_SERVICE",
+		7: "CANCEL",
+	}
+	AgentRequest_Type_value = map[string]int32{
+		"REQUEST_PIN_CODE":      0,
+		"DISPLAY_PIN_CODE":      1,
+		"REQUEST_PASSKEY":       2,
+		"DISPLAY_PASSKEY":       3,
+		"REQUEST_CONFIRMATION":  4,
+		"REQUEST_AUTHORIZATION": 5,
+		"AUTHORIZE_SERVICE":     6,
+		"CANCEL":                7,
+	}
+)
+
+func (x AgentRequest_Type) Enum() *AgentRequest_Type {
+	p := new(AgentRequest_Type)
+	*p = x
+	return p
+}
+
+func (x AgentRequest_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AgentRequest_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_bluetooth_proto_enumTypes[1].Descriptor()
+}
+
+func (AgentRequest_Type) Type() protoreflect.EnumType {
+	return &file_proto_bluetooth_proto_enumTypes[1]
+}
+
+func (x AgentRequest_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AgentRequest_Type.Descriptor instead.
+func (AgentRequest_Type) EnumDescriptor() ([]byte, []int) {
+	return file_proto_bluetooth_proto_rawDescGZIP(), []int{18, 0}
+}
+
+type Health_Status int32
+
+const (
+	Health_SERVING Health_Status = 0
+	// Bluetooth is unavailable or there is no adapter, requests for devices fail until it recovers
+	Health_DEGRADED Health_Status = 1
+)
+
+// Enum value maps for Health_Status.
+var (
+	Health_Status_name = map[int32]string{
+		0: "SERVING",
+		1: "DEGRADED",
+	}
+	Health_Status_value = map[string]int32{
+		"SERVING":  0,
+		"DEGRADED": 1,
+	}
+)
+
+func (x Health_Status) Enum() *Health_Status {
+	p := new(Health_Status)
+	*p = x
+	return p
+}
+
+func (x Health_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Health_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_bluetooth_proto_enumTypes[2].Descriptor()
+}
+
+func (Health_Status) Type() protoreflect.EnumType {
+	return &file_proto_bluetooth_proto_enumTypes[2]
+}
+
+func (x Health_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Health_Status.Descriptor instead.
+func (Health_Status) EnumDescriptor() ([]byte, []int) {
+	return file_proto_bluetooth_proto_rawDescGZIP(), []int{33, 0}
+}
+
+type NowPlaying_Status int32
+
+const (
+	NowPlaying_STOPPED      NowPlaying_Status = 0
+	NowPlaying_PLAYING      NowPlaying_Status = 1
+	NowPlaying_PAUSED       NowPlaying_Status = 2
+	NowPlaying_FORWARD_SEEK NowPlaying_Status = 3
+	NowPlaying_REVERSE_SEEK NowPlaying_Status = 4
+	NowPlaying_ERROR        NowPlaying_Status = 5
+)
+
+// Enum value maps for NowPlaying_Status.
+var (
+	NowPlaying_Status_name = map[int32]string{
+		0: "STOPPED",
+		1: "PLAYING",
+		2: "PAUSED",
+		3: "FORWARD_SEEK",
+		4: "REVERSE_SEEK",
+		5: "ERROR",
+	}
+	NowPlaying_Status_value = map[string]int32{
+		"STOPPED":      0,
+		"PLAYING":      1,
+		"PAUSED":       2,
+		"FORWARD_SEEK": 3,
+		"REVERSE_SEEK": 4,
+		"ERROR":        5,
+	}
+)
+
+func (x NowPlaying_Status) Enum() *NowPlaying_Status {
+	p := new(NowPlaying_Status)
+	*p = x
+	return p
+}
+
+func (x NowPlaying_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NowPlaying_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_bluetooth_proto_enumTypes[3].Descriptor()
+}
+
+func (NowPlaying_Status) Type() protoreflect.EnumType {
+	return &file_proto_bluetooth_proto_enumTypes[3]
+}
+
+func (x NowPlaying_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NowPlaying_Status.Descriptor instead.
+func (NowPlaying_Status) EnumDescriptor() ([]byte, []int) {
+	return file_proto_bluetooth_proto_rawDescGZIP(), []int{35, 0}
+}
+
+type MediaTransport_State int32
+
+const (
+	MediaTransport_IDLE    MediaTransport_State = 0
+	MediaTransport_PENDING MediaTransport_State = 1
+	// Audio is streaming
+	MediaTransport_ACTIVE MediaTransport_State = 2
+)
+
+// Enum value maps for MediaTransport_State.
+var (
+	MediaTransport_State_name = map[int32]string{
+		0: "IDLE",
+		1: "PENDING",
+		2: "ACTIVE",
+	}
+	MediaTransport_State_value = map[string]int32{
+		"IDLE":    0,
+		"PENDING": 1,
+		"ACTIVE":  2,
+	}
+)
+
+func (x MediaTransport_State) Enum() *MediaTransport_State {
+	p := new(MediaTransport_State)
+	*p = x
+	return p
+}
+
+func (x MediaTransport_State) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MediaTransport_State) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_bluetooth_proto_enumTypes[4].Descriptor()
+}
+
+func (MediaTransport_State) Type() protoreflect.EnumType {
+	return &file_proto_bluetooth_proto_enumTypes[4]
+}
+
+func (x MediaTransport_State) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MediaTransport_State.Descriptor instead.
+func (MediaTransport_State) EnumDescriptor() ([]byte, []int) {
+	return file_proto_bluetooth_proto_rawDescGZIP(), []int{36, 0}
+}
+
+type Eddystone_Frame int32
+
+const (
+	Eddystone_UID Eddystone_Frame = 0
+	Eddystone_URL Eddystone_Frame = 1
+	// Telemetry of the beacon
+	Eddystone_TLM Eddystone_Frame = 2
+	// Rotating ephemeral id
+	Eddystone_EID Eddystone_Frame = 3
+)
+
+// Enum value maps for Eddystone_Frame.
+var (
+	Eddystone_Frame_name = map[int32]string{
+		0: "UID",
+		1: "URL",
+		2: "TLM",
+		3: "EID",
+	}
+	Eddystone_Frame_value = map[string]int32{
+		"UID": 0,
+		"URL": 1,
+		"TLM": 2,
+		"EID": 3,
+	}
+)
+
+func (x Eddystone_Frame) Enum() *Eddystone_Frame {
+	p := new(Eddystone_Frame)
+	*p = x
+	return p
+}
+
+func (x Eddystone_Frame) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Eddystone_Frame) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_bluetooth_proto_enumTypes[5].Descriptor()
+}
+
+func (Eddystone_Frame) Type() protoreflect.EnumType {
+	return &file_proto_bluetooth_proto_enumTypes[5]
+}
+
+func (x Eddystone_Frame) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Eddystone_Frame.Descriptor instead.
+func (Eddystone_Frame) EnumDescriptor() ([]byte, []int) {
+	return file_proto_bluetooth_proto_rawDescGZIP(), []int{49, 0}
+}
+
 type Device struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Address       string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	Trusted       bool   `protobuf:"varint,3,opt,name=trusted,proto3" json:"trusted,omitempty"`
-	Paired        bool   `protobuf:"varint,4,opt,name=paired,proto3" json:"paired,omitempty"`
-	Connected     bool   `protobuf:"varint,5,opt,name=connected,proto3" json:"connected,omitempty"`
+	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Address   string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Trusted   bool   `protobuf:"varint,3,opt,name=trusted,proto3" json:"trusted,omitempty"`
+	Paired    bool   `protobuf:"varint,4,opt,name=paired,proto3" json:"paired,omitempty"`
+	Connected bool   `protobuf:"varint,5,opt,name=connected,proto3" json:"connected,omitempty"`
+	// Replaced by battery, still sent for older clients
+	//
+	// Deprecated: Marked as deprecated in proto/bluetooth.proto.
 	BatteryStatus string `protobuf:"bytes,6,opt,name=batteryStatus,proto3" json:"batteryStatus,omitempty"`
 	Icon          string `protobuf:"bytes,7,opt,name=icon,proto3" json:"icon,omitempty"`
+	Alias         string `protobuf:"bytes,8,opt,name=alias,proto3" json:"alias,omitempty"`
+	// Class of device, 0 for Low Energy devices
+	Class uint32 `protobuf:"varint,9,opt,name=class,proto3" json:"class,omitempty"`
+	// GAP appearance, 0 if the device did not advertise one
+	Appearance uint32 `protobuf:"varint,10,opt,name=appearance,proto3" json:"appearance,omitempty"`
+	// Unset when the device is not in range
+	Rssi    *int32   `protobuf:"varint,11,opt,name=rssi,proto3,oneof" json:"rssi,omitempty"`
+	TxPower *int32   `protobuf:"varint,12,opt,name=txPower,proto3,oneof" json:"txPower,omitempty"`
+	Uuids   []string `protobuf:"bytes,13,rep,name=uuids,proto3" json:"uuids,omitempty"`
+	// The services of uuids, by name where the UUID is assigned
+	Profiles      []*Profile `protobuf:"bytes,14,rep,name=profiles,proto3" json:"profiles,omitempty"`
+	Blocked       bool       `protobuf:"varint,15,opt,name=blocked,proto3" json:"blocked,omitempty"`
+	LegacyPairing bool       `protobuf:"varint,16,opt,name=legacyPairing,proto3" json:"legacyPairing,omitempty"`
+	Modalias      *Modalias  `protobuf:"bytes,17,opt,name=modalias,proto3" json:"modalias,omitempty"`
+	// Adapter the device is known to, e.g. hci0
+	AdapterId string `protobuf:"bytes,18,opt,name=adapterId,proto3" json:"adapterId,omitempty"`
+	// Unset when the battery level is unknown
+	Battery *Battery `protobuf:"bytes,19,opt,name=battery,proto3" json:"battery,omitempty"`
+	// The profiles that are connected right now, empty while the device is disconnected
+	ConnectedProfiles []*Profile `protobuf:"bytes,20,rep,name=connectedProfiles,proto3" json:"connectedProfiles,omitempty"`
 }
 
 func (x *Device) Reset() {
@@ -101,6 +452,7 @@ func (x *Device) GetConnected() bool {
 	return false
 }
 
+// Deprecated: Marked as deprecated in proto/bluetooth.proto.
 func (x *Device) GetBatteryStatus() string {
 	if x != nil {
 		return x.BatteryStatus
@@ -115,16 +467,109 @@ func (x *Device) GetIcon() string {
 	return ""
 }
 
-type Devices struct {
+func (x *Device) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
+func (x *Device) GetClass() uint32 {
+	if x != nil {
+		return x.Class
+	}
+	return 0
+}
+
+func (x *Device) GetAppearance() uint32 {
+	if x != nil {
+		return x.Appearance
+	}
+	return 0
+}
+
+func (x *Device) GetRssi() int32 {
+	if x != nil && x.Rssi != nil {
+		return *x.Rssi
+	}
+	return 0
+}
+
+func (x *Device) GetTxPower() int32 {
+	if x != nil && x.TxPower != nil {
+		return *x.TxPower
+	}
+	return 0
+}
+
+func (x *Device) GetUuids() []string {
+	if x != nil {
+		return x.Uuids
+	}
+	return nil
+}
+
+func (x *Device) GetProfiles() []*Profile {
+	if x != nil {
+		return x.Profiles
+	}
+	return nil
+}
+
+func (x *Device) GetBlocked() bool {
+	if x != nil {
+		return x.Blocked
+	}
+	return false
+}
+
+func (x *Device) GetLegacyPairing() bool {
+	if x != nil {
+		return x.LegacyPairing
+	}
+	return false
+}
+
+func (x *Device) GetModalias() *Modalias {
+	if x != nil {
+		return x.Modalias
+	}
+	return nil
+}
+
+func (x *Device) GetAdapterId() string {
+	if x != nil {
+		return x.AdapterId
+	}
+	return ""
+}
+
+func (x *Device) GetBattery() *Battery {
+	if x != nil {
+		return x.Battery
+	}
+	return nil
+}
+
+func (x *Device) GetConnectedProfiles() []*Profile {
+	if x != nil {
+		return x.ConnectedProfiles
+	}
+	return nil
+}
+
+type Battery struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Devices []*Device `protobuf:"bytes,1,rep,name=devices,proto3" json:"devices,omitempty"`
+	Percentage uint32 `protobuf:"varint,1,opt,name=percentage,proto3" json:"percentage,omitempty"`
+	// Where the level comes from, e.g. the BlueZ interface "org.bluez.Battery1"
+	Source string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
 }
 
-func (x *Devices) Reset() {
-	*x = Devices{}
+func (x *Battery) Reset() {
+	*x = Battery{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_bluetooth_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -132,13 +577,13 @@ func (x *Devices) Reset() {
 	}
 }
 
-func (x *Devices) String() string {
+func (x *Battery) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Devices) ProtoMessage() {}
+func (*Battery) ProtoMessage() {}
 
-func (x *Devices) ProtoReflect() protoreflect.Message {
+func (x *Battery) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_bluetooth_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -150,28 +595,36 @@ func (x *Devices) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Devices.ProtoReflect.Descriptor instead.
-func (*Devices) Descriptor() ([]byte, []int) {
+// Deprecated: Use Battery.ProtoReflect.Descriptor instead.
+func (*Battery) Descriptor() ([]byte, []int) {
 	return file_proto_bluetooth_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *Devices) GetDevices() []*Device {
+func (x *Battery) GetPercentage() uint32 {
 	if x != nil {
-		return x.Devices
+		return x.Percentage
 	}
-	return nil
+	return 0
 }
 
-type Response struct {
+func (x *Battery) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+type BatteryHistoryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// Empty for every device with a history
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (x *Response) Reset() {
-	*x = Response{}
+func (x *BatteryHistoryRequest) Reset() {
+	*x = BatteryHistoryRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_bluetooth_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -179,13 +632,13 @@ func (x *Response) Reset() {
 	}
 }
 
-func (x *Response) String() string {
+func (x *BatteryHistoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Response) ProtoMessage() {}
+func (*BatteryHistoryRequest) ProtoMessage() {}
 
-func (x *Response) ProtoReflect() protoreflect.Message {
+func (x *BatteryHistoryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_bluetooth_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -197,28 +650,30 @@ func (x *Response) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Response.ProtoReflect.Descriptor instead.
-func (*Response) Descriptor() ([]byte, []int) {
+// Deprecated: Use BatteryHistoryRequest.ProtoReflect.Descriptor instead.
+func (*BatteryHistoryRequest) Descriptor() ([]byte, []int) {
 	return file_proto_bluetooth_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *Response) GetSuccess() bool {
+func (x *BatteryHistoryRequest) GetAddress() string {
 	if x != nil {
-		return x.Success
+		return x.Address
 	}
-	return false
+	return ""
 }
 
-type ConnectRequest struct {
+type BatterySample struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Unix time in seconds
+	Timestamp  int64  `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Percentage uint32 `protobuf:"varint,2,opt,name=percentage,proto3" json:"percentage,omitempty"`
 }
 
-func (x *ConnectRequest) Reset() {
-	*x = ConnectRequest{}
+func (x *BatterySample) Reset() {
+	*x = BatterySample{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_bluetooth_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -226,13 +681,13 @@ func (x *ConnectRequest) Reset() {
 	}
 }
 
-func (x *ConnectRequest) String() string {
+func (x *BatterySample) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConnectRequest) ProtoMessage() {}
+func (*BatterySample) ProtoMessage() {}
 
-func (x *ConnectRequest) ProtoReflect() protoreflect.Message {
+func (x *BatterySample) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_bluetooth_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -244,28 +699,39 @@ func (x *ConnectRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConnectRequest.ProtoReflect.Descriptor instead.
-func (*ConnectRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BatterySample.ProtoReflect.Descriptor instead.
+func (*BatterySample) Descriptor() ([]byte, []int) {
 	return file_proto_bluetooth_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *ConnectRequest) GetAddress() string {
+func (x *BatterySample) GetTimestamp() int64 {
 	if x != nil {
-		return x.Address
+		return x.Timestamp
 	}
-	return ""
+	return 0
 }
 
-type DisconnectRequest struct {
+func (x *BatterySample) GetPercentage() uint32 {
+	if x != nil {
+		return x.Percentage
+	}
+	return 0
+}
+
+type BatteryHistory struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Oldest first
+	Samples []*BatterySample `protobuf:"bytes,2,rep,name=samples,proto3" json:"samples,omitempty"`
+	// Estimated seconds until the battery is empty, unset while it is not discharging
+	TimeToEmpty *int64 `protobuf:"varint,3,opt,name=timeToEmpty,proto3,oneof" json:"timeToEmpty,omitempty"`
 }
 
-func (x *DisconnectRequest) Reset() {
-	*x = DisconnectRequest{}
+func (x *BatteryHistory) Reset() {
+	*x = BatteryHistory{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_bluetooth_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -273,13 +739,13 @@ func (x *DisconnectRequest) Reset() {
 	}
 }
 
-func (x *DisconnectRequest) String() string {
+func (x *BatteryHistory) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DisconnectRequest) ProtoMessage() {}
+func (*BatteryHistory) ProtoMessage() {}
 
-func (x *DisconnectRequest) ProtoReflect() protoreflect.Message {
+func (x *BatteryHistory) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_bluetooth_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -291,26 +757,47 @@ func (x *DisconnectRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DisconnectRequest.ProtoReflect.Descriptor instead.
-func (*DisconnectRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BatteryHistory.ProtoReflect.Descriptor instead.
+func (*BatteryHistory) Descriptor() ([]byte, []int) {
 	return file_proto_bluetooth_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *DisconnectRequest) GetAddress() string {
+func (x *BatteryHistory) GetAddress() string {
 	if x != nil {
 		return x.Address
 	}
 	return ""
 }
 
-type Empty struct {
+func (x *BatteryHistory) GetSamples() []*BatterySample {
+	if x != nil {
+		return x.Samples
+	}
+	return nil
+}
+
+func (x *BatteryHistory) GetTimeToEmpty() int64 {
+	if x != nil && x.TimeToEmpty != nil {
+		return *x.TimeToEmpty
+	}
+	return 0
+}
+
+type BatteryAlert struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Device *Device `protobuf:"bytes,1,opt,name=device,proto3" json:"device,omitempty"`
+	// The threshold the battery dropped to or below
+	Threshold  uint32 `protobuf:"varint,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Percentage uint32 `protobuf:"varint,3,opt,name=percentage,proto3" json:"percentage,omitempty"`
+	// Estimated seconds until the battery is empty, unset if there is no estimate yet
+	TimeToEmpty *int64 `protobuf:"varint,4,opt,name=timeToEmpty,proto3,oneof" json:"timeToEmpty,omitempty"`
 }
 
-func (x *Empty) Reset() {
-	*x = Empty{}
+func (x *BatteryAlert) Reset() {
+	*x = BatteryAlert{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_bluetooth_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -318,118 +805,4390 @@ func (x *Empty) Reset() {
 	}
 }
 
-func (x *Empty) String() string {
+func (x *BatteryAlert) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Empty) ProtoMessage() {}
+func (*BatteryAlert) ProtoMessage() {}
 
-func (x *Empty) ProtoReflect() protoreflect.Message {
+func (x *BatteryAlert) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_bluetooth_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use Empty.ProtoReflect.Descriptor instead.
-func (*Empty) Descriptor() ([]byte, []int) {
-	return file_proto_bluetooth_proto_rawDescGZIP(), []int{5}
-}
-
-var File_proto_bluetooth_proto protoreflect.FileDescriptor
-
-var file_proto_bluetooth_proto_rawDesc = []byte{
-	0x0a, 0x15, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x62, 0x6c, 0x75, 0x65, 0x74, 0x6f, 0x6f, 0x74,
-	0x68, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04, 0x67, 0x72, 0x70, 0x63, 0x22, 0xc0, 0x01,
-	0x0a, 0x06, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65,
-	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64,
-	0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x69, 0x72, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x06, 0x70, 0x61, 0x69, 0x72, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x6e,
-	0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x6f, 0x6e,
-	0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x62, 0x61, 0x74, 0x74, 0x65, 0x72,
-	0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x62,
-	0x61, 0x74, 0x74, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04,
-	0x69, 0x63, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x63, 0x6f, 0x6e,
-	0x22, 0x31, 0x0a, 0x07, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x07, 0x64,
-	0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x67,
-	0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x07, 0x64, 0x65, 0x76, 0x69,
-	0x63, 0x65, 0x73, 0x22, 0x24, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x2a, 0x0a, 0x0e, 0x43, 0x6f, 0x6e,
-	0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x2d, 0x0a, 0x11, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e,
-	0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x22, 0x07, 0x0a, 0x05, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x32, 0xbc, 0x01,
-	0x0a, 0x09, 0x42, 0x6c, 0x75, 0x65, 0x74, 0x6f, 0x6f, 0x74, 0x68, 0x12, 0x31, 0x0a, 0x11, 0x47,
-	0x65, 0x74, 0x54, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73,
-	0x12, 0x0b, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0d, 0x2e,
-	0x67, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x22, 0x00, 0x12, 0x39,
-	0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x54, 0x6f, 0x44, 0x65, 0x76, 0x69, 0x63,
-	0x65, 0x12, 0x14, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x14, 0x44, 0x69, 0x73,
-	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x46, 0x72, 0x6f, 0x6d, 0x44, 0x65, 0x76, 0x69, 0x63,
-	0x65, 0x12, 0x17, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e,
-	0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x67, 0x72, 0x70,
-	0x63, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x10, 0x5a, 0x0e,
-	0x62, 0x6c, 0x75, 0x65, 0x74, 0x6f, 0x6f, 0x74, 0x68, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_proto_bluetooth_proto_rawDescOnce sync.Once
-	file_proto_bluetooth_proto_rawDescData = file_proto_bluetooth_proto_rawDesc
-)
-
-func file_proto_bluetooth_proto_rawDescGZIP() []byte {
-	file_proto_bluetooth_proto_rawDescOnce.Do(func() {
-		file_proto_bluetooth_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_bluetooth_proto_rawDescData)
-	})
-	return file_proto_bluetooth_proto_rawDescData
-}
-
-var file_proto_bluetooth_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
-var file_proto_bluetooth_proto_goTypes = []interface{}{
-	(*Device)(nil),            // 0: grpc.Device
-	(*Devices)(nil),           // 1: grpc.Devices
-	(*Response)(nil),          // 2: grpc.Response
-	(*ConnectRequest)(nil),    // 3: grpc.ConnectRequest
-	(*DisconnectRequest)(nil), // 4: grpc.DisconnectRequest
-	(*Empty)(nil),             // 5: grpc.Empty
-}
-var file_proto_bluetooth_proto_depIdxs = []int32{
-	0, // 0: grpc.Devices.devices:type_name -> grpc.Device
-	5, // 1: grpc.Bluetooth.GetTrustedDevices:input_type -> grpc.Empty
-	3, // 2: grpc.Bluetooth.ConnectToDevice:input_type -> grpc.ConnectRequest
-	4, // 3: grpc.Bluetooth.DisconnectFromDevice:input_type -> grpc.DisconnectRequest
-	1, // 4: grpc.Bluetooth.GetTrustedDevices:output_type -> grpc.Devices
-	2, // 5: grpc.Bluetooth.ConnectToDevice:output_type -> grpc.Response
-	2, // 6: grpc.Bluetooth.DisconnectFromDevice:output_type -> grpc.Response
-	4, // [4:7] is the sub-list for method output_type
-	1, // [1:4] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
-}
-
-func init() { file_proto_bluetooth_proto_init() }
-func file_proto_bluetooth_proto_init() {
-	if File_proto_bluetooth_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_proto_bluetooth_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Device); i {
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatteryAlert.ProtoReflect.Descriptor instead.
+func (*BatteryAlert) Descriptor() ([]byte, []int) {
+	return file_proto_bluetooth_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *BatteryAlert) GetDevice() *Device {
+	if x != nil {
+		return x.Device
+	}
+	return nil
+}
+
+func (x *BatteryAlert) GetThreshold() uint32 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *BatteryAlert) GetPercentage() uint32 {
+	if x != nil {
+		return x.Percentage
+	}
+	return 0
+}
+
+func (x *BatteryAlert) GetTimeToEmpty() int64 {
+	if x != nil && x.TimeToEmpty != nil {
+		return *x.TimeToEmpty
+	}
+	return 0
+}
+
+type BatteryHistories struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Histories []*BatteryHistory `protobuf:"bytes,1,rep,name=histories,proto3" json:"histories,omitempty"`
+}
+
+func (x *BatteryHistories) Reset() {
+	*x = BatteryHistories{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_bluetooth_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatteryHistories) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatteryHistories) ProtoMessage() {}
+
+func (x *BatteryHistories) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bluetooth_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatteryHistories.ProtoReflect.Descriptor instead.
+func (*BatteryHistories) Descriptor() ([]byte, []int) {
+	return file_proto_bluetooth_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *BatteryHistories) GetHistories() []*BatteryHistory {
+	if x != nil {
+		return x.Histories
+	}
+	return nil
+}
+
+type Profile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *Profile) Reset() {
+	*x = Profile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_bluetooth_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Profile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Profile) ProtoMessage() {}
+
+func (x *Profile) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bluetooth_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Profile.ProtoReflect.Descriptor instead.
+func (*Profile) Descriptor() ([]byte, []int) {
+	return file_proto_bluetooth_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Profile) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *Profile) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// Device ID from the modalias, e.g. usb:v046DpB342d0001
+type Modalias struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// "usb" or "bluetooth", the authority that assigned the vendor ID
+	Source  string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Vendor  uint32 `protobuf:"varint,2,opt,name=vendor,proto3" json:"vendor,omitempty"`
+	Product uint32 `protobuf:"varint,3,opt,name=product,proto3" json:"product,omitempty"`
+	Version uint32 `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *Modalias) Reset() {
+	*x = Modalias{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_bluetooth_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Modalias) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Modalias) ProtoMessage() {}
+
+func (x *Modalias) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bluetooth_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Modalias.ProtoReflect.Descriptor instead.
+func (*Modalias) Descriptor() ([]byte, []int) {
+	return file_proto_bluetooth_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Modalias) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *Modalias) GetVendor() uint32 {
+	if x != nil {
+		return x.Vendor
+	}
+	return 0
+}
+
+func (x *Modalias) GetProduct() uint32 {
+	if x != nil {
+		return x.Product
+	}
+	return 0
+}
+
+func (x *Modalias) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type Devices struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Devices []*Device `protobuf:"bytes,1,rep,name=devices,proto3" json:"devices,omitempty"`
+}
+
+func (x *Devices) Reset() {
+	*x = Devices{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_bluetooth_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Devices) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Devices) ProtoMessage() {}
+
+func (x *Devices) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bluetooth_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Devices.ProtoReflect.Descriptor instead.
+func (*Devices) Descriptor() ([]byte, []int) {
+	return file_proto_bluetooth_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Devices) GetDevices() []*Device {
+	if x != nil {
+		return x.Devices
+	}
+	return nil
+}
+
+type DeviceFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Unset fields match every device
+	Trusted    *bool `protobuf:"varint,1,opt,name=trusted,proto3,oneof" json:"trusted,omitempty"`
+	Paired     *bool `protobuf:"varint,2,opt,name=paired,proto3,oneof" json:"paired,omitempty"`
+	Connected  *bool `protobuf:"varint,3,opt,name=connected,proto3,oneof" json:"connected,omitempty"`
+	Blocked    *bool `protobuf:"varint,4,opt,name=blocked,proto3,oneof" json:"blocked,omitempty"`
+	HasBattery *bool `protobuf:"varint,5,opt,name=hasBattery,proto3,oneof" json:"hasBattery,omitempty"`
+	// Case insensitive substring of the name or the alias
+	Name string `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`
+	// The device matches if its icon, e.g. "input-keyboard", is one of these
+	Icons []string `protobuf:"bytes,7,rep,name=icons,proto3" json:"icons,omitempty"`
+	// The device matches if the major class of its class of device is one of these
+	MajorClasses []uint32 `protobuf:"varint,8,rep,packed,name=majorClasses,proto3" json:"majorClasses,omitempty"`
+	// The device matches if it belongs to one of these adapters, e.g. "hci1"
+	AdapterIds []string `protobuf:"bytes,9,rep,name=adapterIds,proto3" json:"adapterIds,omitempty"`
+}
+
+func (x *DeviceFilter) Reset() {
+	*x = DeviceFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_bluetooth_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeviceFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeviceFilter) ProtoMessage() {}
+
+func (x *DeviceFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bluetooth_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeviceFilter.ProtoReflect.Descriptor instead.
+func (*DeviceFilter) Descriptor() ([]byte, []int) {
+	return file_proto_bluetooth_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DeviceFilter) GetTrusted() bool {
+	if x != nil && x.Trusted != nil {
+		return *x.Trusted
+	}
+	return false
+}
+
+func (x *DeviceFilter) GetPaired() bool {
+	if x != nil && x.Paired != nil {
+		return *x.Paired
+	}
+	return false
+}
+
+func (x *DeviceFilter) GetConnected() bool {
+	if x != nil && x.Connected != nil {
+		return *x.Connected
+	}
+	return false
+}
+
+func (x *DeviceFilter) GetBlocked() bool {
+	if x != nil && x.Blocked != nil {
+		return *x.Blocked
+	}
+	return false
+}
+
+func (x *DeviceFilter) GetHasBattery() bool {
+	if x != nil && x.HasBattery != nil {
+		return *x.HasBattery
+	}
+	return false
+}
+
+func (x *DeviceFilter) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DeviceFilter) GetIcons() []string {
+	if x != nil {
+		return x.Icons
+	}
+	return nil
+}
+
+func (x *DeviceFilter) GetMajorClasses() []uint32 {
+	if x != nil {
+		return x.MajorClasses
+	}
+	return nil
+}
+
+func (x *DeviceFilter) GetAdapterIds() []string {
+	if x != nil {
+		return x.AdapterIds
+	}
+	return nil
+}
+
+type ListDevicesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filter     *DeviceFilter             `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	SortBy     ListDevicesRequest_SortBy `protobuf:"varint,2,opt,name=sortBy,proto3,enum=grpc.ListDevicesRequest_SortBy" json:"sortBy,omitempty"`
+	Descending bool                      `protobuf:"varint,3,opt,name=descending,proto3" json:"descending,omitempty"`
+}
+
+func (x *ListDevicesRequest) Reset() {
+	*x = ListDevicesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_bluetooth_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDevicesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDevicesRequest) ProtoMessage() {}
+
+func (x *ListDevicesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bluetooth_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDevicesRequest.ProtoReflect.Descriptor instead.
+func (*ListDevicesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bluetooth_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListDevicesRequest) GetFilter() *DeviceFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListDevicesRequest) GetSortBy() ListDevicesRequest_SortBy {
+	if x != nil {
+		return x.SortBy
+	}
+	return ListDevicesRequest_NAME
+}
+
+func (x *ListDevicesRequest) GetDescending() bool {
+	if x != nil {
+		return x.Descending
+	}
+	return false
+}
+
+type Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (x *Response) Reset() {
+	*x = Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_bluetooth_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Response) ProtoMessage() {}
+
+func (x *Response) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bluetooth_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Response.ProtoReflect.Descriptor instead.
+func (*Response) Descriptor() ([]byte, []int) {
+	return file_proto_bluetooth_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Response) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ConnectRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// powerOn powers on the adapter first if it is off, instead of failing
+	PowerOn bool `protobuf:"varint,2,opt,name=powerOn,proto3" json:"powerOn,omitempty"`
+	// adapterId picks the adapter, e.g. "hci1", when the device is known to several
+	AdapterId string `protobuf:"bytes,3,opt,name=adapterId,proto3" json:"adapterId,omitempty"`
+}
+
+func (x *ConnectRequest) Reset() {
+	*x = ConnectRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_bluetooth_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConnectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectRequest) ProtoMessage() {}
+
+func (x *ConnectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bluetooth_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectRequest.ProtoReflect.Descriptor instead.
+func (*ConnectRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bluetooth_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ConnectRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *ConnectRequest) GetPowerOn() bool {
+	if x != nil {
+		return x.PowerOn
+	}
+	return false
+}
+
+func (x *ConnectRequest) GetAdapterId() string {
+	if x != nil {
+		return x.AdapterId
+	}
+	return ""
+}
+
+type DisconnectRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address   string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	AdapterId string `protobuf:"bytes,2,opt,name=adapterId,proto3" json:"adapterId,omitempty"`
+}
+
+func (x *DisconnectRequest) Reset() {
+	*x = DisconnectRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_bluetooth_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DisconnectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisconnectRequest) ProtoMessage() {}
+
+func (x *DisconnectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bluetooth_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisconnectRequest.ProtoReflect.Descriptor instead.
+func (*DisconnectRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bluetooth_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DisconnectRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *DisconnectRequest) GetAdapterId() string {
+	if x != nil {
+		return x.AdapterId
+	}
+	return ""
+}
+
+type DeviceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address   string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	AdapterId string `protobuf:"bytes,2,opt,name=adapterId,proto3" json:"adapterId,omitempty"`
+}
+
+func (x *DeviceRequest) Reset() {
+	*x = DeviceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_bluetooth_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeviceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeviceRequest) ProtoMessage() {}
+
+func (x *DeviceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bluetooth_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeviceRequest.ProtoReflect.Descriptor instead.
+func (*DeviceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bluetooth_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DeviceRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *DeviceRequest) GetAdapterId() string {
+	if x != nil {
+		return x.AdapterId
+	}
+	return ""
+}
+
+type ProfileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// profile is a UUID like "0000110b-0000-1000-8000-00805f9b34fb" or "110b", a name like "Audio Sink"
+	// or one of a2dp, avrcp, hfp, hsp, hid, pan and spp
+	Profile   string `protobuf:"bytes,2,opt,name=profile,proto3" json:"profile,omitempty"`
+	AdapterId string `protobuf:"bytes,3,opt,name=adapterId,proto3" json:"adapterId,omitempty"`
+}
+
+func (x *ProfileRequest) Reset() {
+	*x = ProfileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_bluetooth_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProfileRequest) ProtoMessage() {}
+
+func (x *ProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bluetooth_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProfileRequest.ProtoReflect.Descriptor instead.
+func (*ProfileRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bluetooth_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ProfileRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *ProfileRequest) GetProfile() string {
+	if x != nil {
+		return x.Profile
+	}
+	return ""
+}
+
+func (x *ProfileRequest) GetAdapterId() string {
+	if x != nil {
+		return x.AdapterId
+	}
+	return ""
+}
+
+type DiscoveredDevice struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Device *Device `protobuf:"bytes,1,opt,name=device,proto3" json:"device,omitempty"`
+	Rssi   int32   `protobuf:"varint,2,opt,name=rssi,proto3" json:"rssi,omitempty"`
+}
+
+func (x *DiscoveredDevice) Reset() {
+	*x = DiscoveredDevice{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_bluetooth_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiscoveredDevice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscoveredDevice) ProtoMessage() {}
+
+func (x *DiscoveredDevice) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bluetooth_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscoveredDevice.ProtoReflect.Descriptor instead.
+func (*DiscoveredDevice) Descriptor() ([]byte, []int) {
+	return file_proto_bluetooth_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *DiscoveredDevice) GetDevice() *Device {
+	if x != nil {
+		return x.Device
+	}
+	return nil
+}
+
+func (x *DiscoveredDevice) GetRssi() int32 {
+	if x != nil {
+		return x.Rssi
+	}
+	return 0
+}
+
+type AgentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type    AgentRequest_Type `protobuf:"varint,2,opt,name=type,proto3,enum=grpc.AgentRequest_Type" json:"type,omitempty"`
+	Device  *Device           `protobuf:"bytes,3,opt,name=device,proto3" json:"device,omitempty"`
+	PinCode string            `protobuf:"bytes,4,opt,name=pinCode,proto3" json:"pinCode,omitempty"`
+	Passkey uint32            `protobuf:"varint,5,opt,name=passkey,proto3" json:"passkey,omitempty"`
+	Entered uint32            `protobuf:"varint,6,opt,name=entered,proto3" json:"entered,omitempty"`
+	Uuid    string            `protobuf:"bytes,7,opt,name=uuid,proto3" json:"uuid,omitempty"`
+}
+
+func (x *AgentRequest) Reset() {
+	*x = AgentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_bluetooth_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AgentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgentRequest) ProtoMessage() {}
+
+func (x *AgentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bluetooth_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgentRequest.ProtoReflect.Descriptor instead.
+func (*AgentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bluetooth_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *AgentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AgentRequest) GetType() AgentRequest_Type {
+	if x != nil {
+		return x.Type
+	}
+	return AgentRequest_REQUEST_PIN_CODE
+}
+
+func (x *AgentRequest) GetDevice() *Device {
+	if x != nil {
+		return x.Device
+	}
+	return nil
+}
+
+func (x *AgentRequest) GetPinCode() string {
+	if x != nil {
+		return x.PinCode
+	}
+	return ""
+}
+
+func (x *AgentRequest) GetPasskey() uint32 {
+	if x != nil {
+		return x.Passkey
+	}
+	return 0
+}
+
+func (x *AgentRequest) GetEntered() uint32 {
+	if x != nil {
+		return x.Entered
+	}
+	return 0
+}
+
+func (x *AgentRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+type AgentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Accept  bool   `protobuf:"varint,2,opt,name=accept,proto3" json:"accept,omitempty"`
+	PinCode string `protobuf:"bytes,3,opt,name=pinCode,proto3" json:"pinCode,omitempty"`
+	Passkey uint32 `protobuf:"varint,4,opt,name=passkey,proto3" json:"passkey,omitempty"`
+}
+
+func (x *AgentResponse) Reset() {
+	*x = AgentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_bluetooth_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AgentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgentResponse) ProtoMessage() {}
+
+func (x *AgentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bluetooth_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgentResponse.ProtoReflect.Descriptor instead.
+func (*AgentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_bluetooth_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *AgentResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AgentResponse) GetAccept() bool {
+	if x != nil {
+		return x.Accept
+	}
+	return false
+}
+
+func (x *AgentResponse) GetPinCode() string {
+	if x != nil {
+		return x.PinCode
+	}
+	return ""
+}
+
+func (x *AgentResponse) GetPasskey() uint32 {
+	if x != nil {
+		return x.Passkey
+	}
+	return 0
+}
+
+type Empty struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *Empty) Reset() {
+	*x = Empty{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_bluetooth_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Empty) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Empty) ProtoMessage() {}
+
+func (x *Empty) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bluetooth_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Empty.ProtoReflect.Descriptor instead.
+func (*Empty) Descriptor() ([]byte, []int) {
+	return file_proto_bluetooth_proto_rawDescGZIP(), []int{20}
+}
+
+type ServerInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *ServerInfo) Reset() {
+	*x = ServerInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_bluetooth_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerInfo) ProtoMessage() {}
+
+func (x *ServerInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bluetooth_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerInfo.ProtoReflect.Descriptor instead.
+func (*ServerInfo) Descriptor() ([]byte, []int) {
+	return file_proto_bluetooth_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ServerInfo) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ServerInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type PairClientRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClientName string `protobuf:"bytes,1,opt,name=clientName,proto3" json:"clientName,omitempty"`
+}
+
+func (x *PairClientRequest) Reset() {
+	*x = PairClientRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_bluetooth_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PairClientRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PairClientRequest) ProtoMessage() {}
+
+func (x *PairClientRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bluetooth_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PairClientRequest.ProtoReflect.Descriptor instead.
+func (*PairClientRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bluetooth_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *PairClientRequest) GetClientName() string {
+	if x != nil {
+		return x.ClientName
+	}
+	return ""
+}
+
+type PairClientResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PairingId string `protobuf:"bytes,1,opt,name=pairingId,proto3" json:"pairingId,omitempty"`
+}
+
+func (x *PairClientResponse) Reset() {
+	*x = PairClientResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_bluetooth_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PairClientResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PairClientResponse) ProtoMessage() {}
+
+func (x *PairClientResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bluetooth_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PairClientResponse.ProtoReflect.Descriptor instead.
+func (*PairClientResponse) Descriptor() ([]byte, []int) {
+	return file_proto_bluetooth_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *PairClientResponse) GetPairingId() string {
+	if x != nil {
+		return x.PairingId
+	}
+	return ""
+}
+
+type ConfirmPairingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PairingId string `protobuf:"bytes,1,opt,name=pairingId,proto3" json:"pairingId,omitempty"`
+	Code      string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+}
+
+func (x *ConfirmPairingRequest) Reset() {
+	*x = ConfirmPairingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_bluetooth_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfirmPairingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmPairingRequest) ProtoMessage() {}
+
+func (x *ConfirmPairingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bluetooth_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmPairingRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmPairingRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bluetooth_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ConfirmPairingRequest) GetPairingId() string {
+	if x != nil {
+		return x.PairingId
+	}
+	return ""
+}
+
+func (x *ConfirmPairingRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type ConfirmPairingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *ConfirmPairingResponse) Reset() {
+	*x = ConfirmPairingResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_bluetooth_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfirmPairingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmPairingResponse) ProtoMessage() {}
+
+func (x *ConfirmPairingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bluetooth_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmPairingResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmPairingResponse) Descriptor() ([]byte, []int) {
+	return file_proto_bluetooth_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ConfirmPairingResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type Adapter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Address      string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Name         string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Alias        string `protobuf:"bytes,4,opt,name=alias,proto3" json:"alias,omitempty"`
+	Powered      bool   `protobuf:"varint,5,opt,name=powered,proto3" json:"powered,omitempty"`
+	Discoverable bool   `protobuf:"varint,6,opt,name=discoverable,proto3" json:"discoverable,omitempty"`
+	// discoverableTimeout is in seconds, 0 means the adapter stays discoverable
+	DiscoverableTimeout uint32 `protobuf:"varint,7,opt,name=discoverableTimeout,proto3" json:"discoverableTimeout,omitempty"`
+	Pairable            bool   `protobuf:"varint,8,opt,name=pairable,proto3" json:"pairable,omitempty"`
+	Discovering         bool   `protobuf:"varint,9,opt,name=discovering,proto3" json:"discovering,omitempty"`
+}
+
+func (x *Adapter) Reset() {
+	*x = Adapter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_bluetooth_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Adapter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Adapter) ProtoMessage() {}
+
+func (x *Adapter) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bluetooth_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Adapter.ProtoReflect.Descriptor instead.
+func (*Adapter) Descriptor() ([]byte, []int) {
+	return file_proto_bluetooth_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *Adapter) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Adapter) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Adapter) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Adapter) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
+func (x *Adapter) GetPowered() bool {
+	if x != nil {
+		return x.Powered
+	}
+	return false
+}
+
+func (x *Adapter) GetDiscoverable() bool {
+	if x != nil {
+		return x.Discoverable
+	}
+	return false
+}
+
+func (x *Adapter) GetDiscoverableTimeout() uint32 {
+	if x != nil {
+		return x.DiscoverableTimeout
+	}
+	return 0
+}
+
+func (x *Adapter) GetPairable() bool {
+	if x != nil {
+		return x.Pairable
+	}
+	return false
+}
+
+func (x *Adapter) GetDiscovering() bool {
+	if x != nil {
+		return x.Discovering
+	}
+	return false
+}
+
+type Adapters struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Adapters []*Adapter `protobuf:"bytes,1,rep,name=adapters,proto3" json:"adapters,omitempty"`
+}
+
+func (x *Adapters) Reset() {
+	*x = Adapters{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_bluetooth_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Adapters) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Adapters) ProtoMessage() {}
+
+func (x *Adapters) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bluetooth_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Adapters.ProtoReflect.Descriptor instead.
+func (*Adapters) Descriptor() ([]byte, []int) {
+	return file_proto_bluetooth_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *Adapters) GetAdapters() []*Adapter {
+	if x != nil {
+		return x.Adapters
+	}
+	return nil
+}
+
+// An empty adapterId means the default adapter, except for discovery where it means every adapter
+type AdapterRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AdapterId string `protobuf:"bytes,1,opt,name=adapterId,proto3" json:"adapterId,omitempty"`
+}
+
+func (x *AdapterRequest) Reset() {
+	*x = AdapterRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_bluetooth_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdapterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdapterRequest) ProtoMessage() {}
+
+func (x *AdapterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bluetooth_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdapterRequest.ProtoReflect.Descriptor instead.
+func (*AdapterRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bluetooth_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *AdapterRequest) GetAdapterId() string {
+	if x != nil {
+		return x.AdapterId
+	}
+	return ""
+}
+
+type SetPoweredRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Powered   bool   `protobuf:"varint,1,opt,name=powered,proto3" json:"powered,omitempty"`
+	AdapterId string `protobuf:"bytes,2,opt,name=adapterId,proto3" json:"adapterId,omitempty"`
+}
+
+func (x *SetPoweredRequest) Reset() {
+	*x = SetPoweredRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_bluetooth_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetPoweredRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPoweredRequest) ProtoMessage() {}
+
+func (x *SetPoweredRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bluetooth_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPoweredRequest.ProtoReflect.Descriptor instead.
+func (*SetPoweredRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bluetooth_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SetPoweredRequest) GetPowered() bool {
+	if x != nil {
+		return x.Powered
+	}
+	return false
+}
+
+func (x *SetPoweredRequest) GetAdapterId() string {
+	if x != nil {
+		return x.AdapterId
+	}
+	return ""
+}
+
+type SetDiscoverableRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Discoverable bool `protobuf:"varint,1,opt,name=discoverable,proto3" json:"discoverable,omitempty"`
+	// timeout is in seconds, 0 means the adapter stays discoverable
+	Timeout   uint32 `protobuf:"varint,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	AdapterId string `protobuf:"bytes,3,opt,name=adapterId,proto3" json:"adapterId,omitempty"`
+}
+
+func (x *SetDiscoverableRequest) Reset() {
+	*x = SetDiscoverableRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_bluetooth_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetDiscoverableRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDiscoverableRequest) ProtoMessage() {}
+
+func (x *SetDiscoverableRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bluetooth_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDiscoverableRequest.ProtoReflect.Descriptor instead.
+func (*SetDiscoverableRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bluetooth_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SetDiscoverableRequest) GetDiscoverable() bool {
+	if x != nil {
+		return x.Discoverable
+	}
+	return false
+}
+
+func (x *SetDiscoverableRequest) GetTimeout() uint32 {
+	if x != nil {
+		return x.Timeout
+	}
+	return 0
+}
+
+func (x *SetDiscoverableRequest) GetAdapterId() string {
+	if x != nil {
+		return x.AdapterId
+	}
+	return ""
+}
+
+type SetPairableRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pairable  bool   `protobuf:"varint,1,opt,name=pairable,proto3" json:"pairable,omitempty"`
+	AdapterId string `protobuf:"bytes,2,opt,name=adapterId,proto3" json:"adapterId,omitempty"`
+}
+
+func (x *SetPairableRequest) Reset() {
+	*x = SetPairableRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_bluetooth_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetPairableRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPairableRequest) ProtoMessage() {}
+
+func (x *SetPairableRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bluetooth_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPairableRequest.ProtoReflect.Descriptor instead.
+func (*SetPairableRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bluetooth_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SetPairableRequest) GetPairable() bool {
+	if x != nil {
+		return x.Pairable
+	}
+	return false
+}
+
+func (x *SetPairableRequest) GetAdapterId() string {
+	if x != nil {
+		return x.AdapterId
+	}
+	return ""
+}
+
+type SetAliasRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Alias     string `protobuf:"bytes,1,opt,name=alias,proto3" json:"alias,omitempty"`
+	AdapterId string `protobuf:"bytes,2,opt,name=adapterId,proto3" json:"adapterId,omitempty"`
+}
+
+func (x *SetAliasRequest) Reset() {
+	*x = SetAliasRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_bluetooth_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetAliasRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAliasRequest) ProtoMessage() {}
+
+func (x *SetAliasRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bluetooth_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAliasRequest.ProtoReflect.Descriptor instead.
+func (*SetAliasRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bluetooth_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SetAliasRequest) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
+func (x *SetAliasRequest) GetAdapterId() string {
+	if x != nil {
+		return x.AdapterId
+	}
+	return ""
+}
+
+type Health struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status Health_Status `protobuf:"varint,1,opt,name=status,proto3,enum=grpc.Health_Status" json:"status,omitempty"`
+	// reason tells why the server is degraded
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// bluetoothAvailable is whether the Bluetooth service, bluetoothd, is running
+	BluetoothAvailable bool     `protobuf:"varint,3,opt,name=bluetoothAvailable,proto3" json:"bluetoothAvailable,omitempty"`
+	AdapterIds         []string `protobuf:"bytes,4,rep,name=adapterIds,proto3" json:"adapterIds,omitempty"`
+}
+
+func (x *Health) Reset() {
+	*x = Health{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_bluetooth_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Health) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Health) ProtoMessage() {}
+
+func (x *Health) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bluetooth_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Health.ProtoReflect.Descriptor instead.
+func (*Health) Descriptor() ([]byte, []int) {
+	return file_proto_bluetooth_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *Health) GetStatus() Health_Status {
+	if x != nil {
+		return x.Status
+	}
+	return Health_SERVING
+}
+
+func (x *Health) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Health) GetBluetoothAvailable() bool {
+	if x != nil {
+		return x.BluetoothAvailable
+	}
+	return false
+}
+
+func (x *Health) GetAdapterIds() []string {
+	if x != nil {
+		return x.AdapterIds
+	}
+	return nil
+}
+
+type Track struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Title          string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Artist         string `protobuf:"bytes,2,opt,name=artist,proto3" json:"artist,omitempty"`
+	Album          string `protobuf:"bytes,3,opt,name=album,proto3" json:"album,omitempty"`
+	Genre          string `protobuf:"bytes,4,opt,name=genre,proto3" json:"genre,omitempty"`
+	TrackNumber    uint32 `protobuf:"varint,5,opt,name=trackNumber,proto3" json:"trackNumber,omitempty"`
+	NumberOfTracks uint32 `protobuf:"varint,6,opt,name=numberOfTracks,proto3" json:"numberOfTracks,omitempty"`
+	// duration is in milliseconds, 0 if unknown
+	Duration uint32 `protobuf:"varint,7,opt,name=duration,proto3" json:"duration,omitempty"`
+}
+
+func (x *Track) Reset() {
+	*x = Track{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_bluetooth_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Track) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Track) ProtoMessage() {}
+
+func (x *Track) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bluetooth_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Track.ProtoReflect.Descriptor instead.
+func (*Track) Descriptor() ([]byte, []int) {
+	return file_proto_bluetooth_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *Track) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Track) GetArtist() string {
+	if x != nil {
+		return x.Artist
+	}
+	return ""
+}
+
+func (x *Track) GetAlbum() string {
+	if x != nil {
+		return x.Album
+	}
+	return ""
+}
+
+func (x *Track) GetGenre() string {
+	if x != nil {
+		return x.Genre
+	}
+	return ""
+}
+
+func (x *Track) GetTrackNumber() uint32 {
+	if x != nil {
+		return x.TrackNumber
+	}
+	return 0
+}
+
+func (x *Track) GetNumberOfTracks() uint32 {
+	if x != nil {
+		return x.NumberOfTracks
+	}
+	return 0
+}
+
+func (x *Track) GetDuration() uint32 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+type NowPlaying struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string            `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Status  NowPlaying_Status `protobuf:"varint,2,opt,name=status,proto3,enum=grpc.NowPlaying_Status" json:"status,omitempty"`
+	Track   *Track            `protobuf:"bytes,3,opt,name=track,proto3" json:"track,omitempty"`
+	// position is in milliseconds when the message was sent. Devices only report it when the status or
+	// track changes, so it has to be advanced locally while playing.
+	Position uint32 `protobuf:"varint,4,opt,name=position,proto3" json:"position,omitempty"`
+	// player is the name of the application playing on the device, if it reports one
+	Player string `protobuf:"bytes,5,opt,name=player,proto3" json:"player,omitempty"`
+}
+
+func (x *NowPlaying) Reset() {
+	*x = NowPlaying{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_bluetooth_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NowPlaying) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NowPlaying) ProtoMessage() {}
+
+func (x *NowPlaying) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bluetooth_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NowPlaying.ProtoReflect.Descriptor instead.
+func (*NowPlaying) Descriptor() ([]byte, []int) {
+	return file_proto_bluetooth_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *NowPlaying) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *NowPlaying) GetStatus() NowPlaying_Status {
+	if x != nil {
+		return x.Status
+	}
+	return NowPlaying_STOPPED
+}
+
+func (x *NowPlaying) GetTrack() *Track {
+	if x != nil {
+		return x.Track
+	}
+	return nil
+}
+
+func (x *NowPlaying) GetPosition() uint32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *NowPlaying) GetPlayer() string {
+	if x != nil {
+		return x.Player
+	}
+	return ""
+}
+
+type MediaTransport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id identifies the transport on its device, e.g. "sep1/fd0". It changes when the profile reconnects.
+	Id      string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Address string               `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Profile *Profile             `protobuf:"bytes,3,opt,name=profile,proto3" json:"profile,omitempty"`
+	State   MediaTransport_State `protobuf:"varint,4,opt,name=state,proto3,enum=grpc.MediaTransport_State" json:"state,omitempty"`
+	Codec   uint32               `protobuf:"varint,5,opt,name=codec,proto3" json:"codec,omitempty"`
+	// volume is between 0 and 127, unset if the device does not support absolute volume
+	Volume *uint32 `protobuf:"varint,6,opt,name=volume,proto3,oneof" json:"volume,omitempty"`
+}
+
+func (x *MediaTransport) Reset() {
+	*x = MediaTransport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_bluetooth_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MediaTransport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MediaTransport) ProtoMessage() {}
+
+func (x *MediaTransport) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bluetooth_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MediaTransport.ProtoReflect.Descriptor instead.
+func (*MediaTransport) Descriptor() ([]byte, []int) {
+	return file_proto_bluetooth_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *MediaTransport) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MediaTransport) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *MediaTransport) GetProfile() *Profile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+func (x *MediaTransport) GetState() MediaTransport_State {
+	if x != nil {
+		return x.State
+	}
+	return MediaTransport_IDLE
+}
+
+func (x *MediaTransport) GetCodec() uint32 {
+	if x != nil {
+		return x.Codec
+	}
+	return 0
+}
+
+func (x *MediaTransport) GetVolume() uint32 {
+	if x != nil && x.Volume != nil {
+		return *x.Volume
+	}
+	return 0
+}
+
+type MediaTransports struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Transports []*MediaTransport `protobuf:"bytes,1,rep,name=transports,proto3" json:"transports,omitempty"`
+}
+
+func (x *MediaTransports) Reset() {
+	*x = MediaTransports{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_bluetooth_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MediaTransports) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MediaTransports) ProtoMessage() {}
+
+func (x *MediaTransports) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bluetooth_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MediaTransports.ProtoReflect.Descriptor instead.
+func (*MediaTransports) Descriptor() ([]byte, []int) {
+	return file_proto_bluetooth_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *MediaTransports) GetTransports() []*MediaTransport {
+	if x != nil {
+		return x.Transports
+	}
+	return nil
+}
+
+// An empty transportId picks the first transport of the device with a volume
+type TransportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address     string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	TransportId string `protobuf:"bytes,2,opt,name=transportId,proto3" json:"transportId,omitempty"`
+	AdapterId   string `protobuf:"bytes,3,opt,name=adapterId,proto3" json:"adapterId,omitempty"`
+}
+
+func (x *TransportRequest) Reset() {
+	*x = TransportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_bluetooth_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransportRequest) ProtoMessage() {}
+
+func (x *TransportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bluetooth_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransportRequest.ProtoReflect.Descriptor instead.
+func (*TransportRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bluetooth_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *TransportRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *TransportRequest) GetTransportId() string {
+	if x != nil {
+		return x.TransportId
+	}
+	return ""
+}
+
+func (x *TransportRequest) GetAdapterId() string {
+	if x != nil {
+		return x.AdapterId
+	}
+	return ""
+}
+
+type SetVolumeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address     string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	TransportId string `protobuf:"bytes,2,opt,name=transportId,proto3" json:"transportId,omitempty"`
+	// volume is between 0 and 127
+	Volume    uint32 `protobuf:"varint,3,opt,name=volume,proto3" json:"volume,omitempty"`
+	AdapterId string `protobuf:"bytes,4,opt,name=adapterId,proto3" json:"adapterId,omitempty"`
+}
+
+func (x *SetVolumeRequest) Reset() {
+	*x = SetVolumeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_bluetooth_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetVolumeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetVolumeRequest) ProtoMessage() {}
+
+func (x *SetVolumeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bluetooth_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetVolumeRequest.ProtoReflect.Descriptor instead.
+func (*SetVolumeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bluetooth_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *SetVolumeRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *SetVolumeRequest) GetTransportId() string {
+	if x != nil {
+		return x.TransportId
+	}
+	return ""
+}
+
+func (x *SetVolumeRequest) GetVolume() uint32 {
+	if x != nil {
+		return x.Volume
+	}
+	return 0
+}
+
+func (x *SetVolumeRequest) GetAdapterId() string {
+	if x != nil {
+		return x.AdapterId
+	}
+	return ""
+}
+
+type GattDescriptor struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id identifies the descriptor on its device, e.g. "service000a/char000b/desc000d"
+	Id    string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Uuid  string   `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Flags []string `protobuf:"bytes,3,rep,name=flags,proto3" json:"flags,omitempty"`
+}
+
+func (x *GattDescriptor) Reset() {
+	*x = GattDescriptor{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_bluetooth_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GattDescriptor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GattDescriptor) ProtoMessage() {}
+
+func (x *GattDescriptor) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bluetooth_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GattDescriptor.ProtoReflect.Descriptor instead.
+func (*GattDescriptor) Descriptor() ([]byte, []int) {
+	return file_proto_bluetooth_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GattDescriptor) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GattDescriptor) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *GattDescriptor) GetFlags() []string {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+type GattCharacteristic struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id identifies the characteristic on its device, e.g. "service000a/char000b"
+	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Uuid string `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// What the characteristic allows, e.g. "read", "write", "write-without-response", "notify" and "indicate"
+	Flags       []string          `protobuf:"bytes,3,rep,name=flags,proto3" json:"flags,omitempty"`
+	Descriptors []*GattDescriptor `protobuf:"bytes,4,rep,name=descriptors,proto3" json:"descriptors,omitempty"`
+}
+
+func (x *GattCharacteristic) Reset() {
+	*x = GattCharacteristic{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_bluetooth_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GattCharacteristic) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GattCharacteristic) ProtoMessage() {}
+
+func (x *GattCharacteristic) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bluetooth_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GattCharacteristic.ProtoReflect.Descriptor instead.
+func (*GattCharacteristic) Descriptor() ([]byte, []int) {
+	return file_proto_bluetooth_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GattCharacteristic) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GattCharacteristic) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *GattCharacteristic) GetFlags() []string {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+func (x *GattCharacteristic) GetDescriptors() []*GattDescriptor {
+	if x != nil {
+		return x.Descriptors
+	}
+	return nil
+}
+
+type GattService struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id identifies the service on its device, e.g. "service000a"
+	Id              string                `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Profile         *Profile              `protobuf:"bytes,2,opt,name=profile,proto3" json:"profile,omitempty"`
+	Primary         bool                  `protobuf:"varint,3,opt,name=primary,proto3" json:"primary,omitempty"`
+	Characteristics []*GattCharacteristic `protobuf:"bytes,4,rep,name=characteristics,proto3" json:"characteristics,omitempty"`
+}
+
+func (x *GattService) Reset() {
+	*x = GattService{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_bluetooth_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GattService) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GattService) ProtoMessage() {}
+
+func (x *GattService) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bluetooth_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GattService.ProtoReflect.Descriptor instead.
+func (*GattService) Descriptor() ([]byte, []int) {
+	return file_proto_bluetooth_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GattService) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GattService) GetProfile() *Profile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+func (x *GattService) GetPrimary() bool {
+	if x != nil {
+		return x.Primary
+	}
+	return false
+}
+
+func (x *GattService) GetCharacteristics() []*GattCharacteristic {
+	if x != nil {
+		return x.Characteristics
+	}
+	return nil
+}
+
+type GattServices struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Services []*GattService `protobuf:"bytes,1,rep,name=services,proto3" json:"services,omitempty"`
+}
+
+func (x *GattServices) Reset() {
+	*x = GattServices{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_bluetooth_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GattServices) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GattServices) ProtoMessage() {}
+
+func (x *GattServices) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bluetooth_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GattServices.ProtoReflect.Descriptor instead.
+func (*GattServices) Descriptor() ([]byte, []int) {
+	return file_proto_bluetooth_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GattServices) GetServices() []*GattService {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+type CharacteristicRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// characteristic is an id like "service000a/char000b", or a UUID like "2a19" if no other
+	// characteristic of the device has it
+	Characteristic string `protobuf:"bytes,2,opt,name=characteristic,proto3" json:"characteristic,omitempty"`
+	AdapterId      string `protobuf:"bytes,3,opt,name=adapterId,proto3" json:"adapterId,omitempty"`
+}
+
+func (x *CharacteristicRequest) Reset() {
+	*x = CharacteristicRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_bluetooth_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CharacteristicRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CharacteristicRequest) ProtoMessage() {}
+
+func (x *CharacteristicRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bluetooth_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CharacteristicRequest.ProtoReflect.Descriptor instead.
+func (*CharacteristicRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bluetooth_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *CharacteristicRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *CharacteristicRequest) GetCharacteristic() string {
+	if x != nil {
+		return x.Characteristic
+	}
+	return ""
+}
+
+func (x *CharacteristicRequest) GetAdapterId() string {
+	if x != nil {
+		return x.AdapterId
+	}
+	return ""
+}
+
+type WriteCharacteristicRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address        string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Characteristic string `protobuf:"bytes,2,opt,name=characteristic,proto3" json:"characteristic,omitempty"`
+	Value          []byte `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	// withoutResponse does not wait for the device to confirm the write
+	WithoutResponse bool   `protobuf:"varint,4,opt,name=withoutResponse,proto3" json:"withoutResponse,omitempty"`
+	AdapterId       string `protobuf:"bytes,5,opt,name=adapterId,proto3" json:"adapterId,omitempty"`
+}
+
+func (x *WriteCharacteristicRequest) Reset() {
+	*x = WriteCharacteristicRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_bluetooth_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WriteCharacteristicRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WriteCharacteristicRequest) ProtoMessage() {}
+
+func (x *WriteCharacteristicRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bluetooth_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WriteCharacteristicRequest.ProtoReflect.Descriptor instead.
+func (*WriteCharacteristicRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bluetooth_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *WriteCharacteristicRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *WriteCharacteristicRequest) GetCharacteristic() string {
+	if x != nil {
+		return x.Characteristic
+	}
+	return ""
+}
+
+func (x *WriteCharacteristicRequest) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *WriteCharacteristicRequest) GetWithoutResponse() bool {
+	if x != nil {
+		return x.WithoutResponse
+	}
+	return false
+}
+
+func (x *WriteCharacteristicRequest) GetAdapterId() string {
+	if x != nil {
+		return x.AdapterId
+	}
+	return ""
+}
+
+type CharacteristicValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// id of the characteristic, e.g. "service000a/char000b"
+	CharacteristicId string `protobuf:"bytes,2,opt,name=characteristicId,proto3" json:"characteristicId,omitempty"`
+	Value            []byte `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *CharacteristicValue) Reset() {
+	*x = CharacteristicValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_bluetooth_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CharacteristicValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CharacteristicValue) ProtoMessage() {}
+
+func (x *CharacteristicValue) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bluetooth_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CharacteristicValue.ProtoReflect.Descriptor instead.
+func (*CharacteristicValue) Descriptor() ([]byte, []int) {
+	return file_proto_bluetooth_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *CharacteristicValue) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *CharacteristicValue) GetCharacteristicId() string {
+	if x != nil {
+		return x.CharacteristicId
+	}
+	return ""
+}
+
+func (x *CharacteristicValue) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type ObserveRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// adapterId is empty to observe with every adapter
+	AdapterId string `protobuf:"bytes,1,opt,name=adapterId,proto3" json:"adapterId,omitempty"`
+	// uuids are service UUIDs, one of which the device must advertise. Short UUIDs like "feaa" are allowed.
+	Uuids []string `protobuf:"bytes,2,rep,name=uuids,proto3" json:"uuids,omitempty"`
+	// rssi is the lowest RSSI that is sent, 0 for any
+	Rssi int32 `protobuf:"varint,3,opt,name=rssi,proto3" json:"rssi,omitempty"`
+	// pattern is a prefix of the address or name of the device
+	Pattern string `protobuf:"bytes,4,opt,name=pattern,proto3" json:"pattern,omitempty"`
+}
+
+func (x *ObserveRequest) Reset() {
+	*x = ObserveRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_bluetooth_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ObserveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ObserveRequest) ProtoMessage() {}
+
+func (x *ObserveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bluetooth_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ObserveRequest.ProtoReflect.Descriptor instead.
+func (*ObserveRequest) Descriptor() ([]byte, []int) {
+	return file_proto_bluetooth_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ObserveRequest) GetAdapterId() string {
+	if x != nil {
+		return x.AdapterId
+	}
+	return ""
+}
+
+func (x *ObserveRequest) GetUuids() []string {
+	if x != nil {
+		return x.Uuids
+	}
+	return nil
+}
+
+func (x *ObserveRequest) GetRssi() int32 {
+	if x != nil {
+		return x.Rssi
+	}
+	return 0
+}
+
+func (x *ObserveRequest) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+type IBeacon struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uuid  string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Major uint32 `protobuf:"varint,2,opt,name=major,proto3" json:"major,omitempty"`
+	Minor uint32 `protobuf:"varint,3,opt,name=minor,proto3" json:"minor,omitempty"`
+	// txPower is the calibrated RSSI at 1 m in dBm
+	TxPower int32 `protobuf:"varint,4,opt,name=txPower,proto3" json:"txPower,omitempty"`
+}
+
+func (x *IBeacon) Reset() {
+	*x = IBeacon{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_bluetooth_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IBeacon) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IBeacon) ProtoMessage() {}
+
+func (x *IBeacon) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bluetooth_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IBeacon.ProtoReflect.Descriptor instead.
+func (*IBeacon) Descriptor() ([]byte, []int) {
+	return file_proto_bluetooth_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *IBeacon) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *IBeacon) GetMajor() uint32 {
+	if x != nil {
+		return x.Major
+	}
+	return 0
+}
+
+func (x *IBeacon) GetMinor() uint32 {
+	if x != nil {
+		return x.Minor
+	}
+	return 0
+}
+
+func (x *IBeacon) GetTxPower() int32 {
+	if x != nil {
+		return x.TxPower
+	}
+	return 0
+}
+
+type Eddystone struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Frame Eddystone_Frame `protobuf:"varint,1,opt,name=frame,proto3,enum=grpc.Eddystone_Frame" json:"frame,omitempty"`
+	// txPower is the calibrated RSSI at 0 m in dBm, of UID, URL and EID frames
+	TxPower   int32  `protobuf:"varint,2,opt,name=txPower,proto3" json:"txPower,omitempty"`
+	Namespace []byte `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Instance  []byte `protobuf:"bytes,4,opt,name=instance,proto3" json:"instance,omitempty"`
+	Url       string `protobuf:"bytes,5,opt,name=url,proto3" json:"url,omitempty"`
+	// batteryVoltage is in mV, 0 if the beacon does not report it
+	BatteryVoltage uint32 `protobuf:"varint,6,opt,name=batteryVoltage,proto3" json:"batteryVoltage,omitempty"`
+	// temperature is in °C, unset if the beacon does not report it
+	Temperature        *float64 `protobuf:"fixed64,7,opt,name=temperature,proto3,oneof" json:"temperature,omitempty"`
+	AdvertisementCount uint32   `protobuf:"varint,8,opt,name=advertisementCount,proto3" json:"advertisementCount,omitempty"`
+	// uptime is in milliseconds
+	Uptime int64  `protobuf:"varint,9,opt,name=uptime,proto3" json:"uptime,omitempty"`
+	Eid    []byte `protobuf:"bytes,10,opt,name=eid,proto3" json:"eid,omitempty"`
+}
+
+func (x *Eddystone) Reset() {
+	*x = Eddystone{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_bluetooth_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Eddystone) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Eddystone) ProtoMessage() {}
+
+func (x *Eddystone) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bluetooth_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Eddystone.ProtoReflect.Descriptor instead.
+func (*Eddystone) Descriptor() ([]byte, []int) {
+	return file_proto_bluetooth_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *Eddystone) GetFrame() Eddystone_Frame {
+	if x != nil {
+		return x.Frame
+	}
+	return Eddystone_UID
+}
+
+func (x *Eddystone) GetTxPower() int32 {
+	if x != nil {
+		return x.TxPower
+	}
+	return 0
+}
+
+func (x *Eddystone) GetNamespace() []byte {
+	if x != nil {
+		return x.Namespace
+	}
+	return nil
+}
+
+func (x *Eddystone) GetInstance() []byte {
+	if x != nil {
+		return x.Instance
+	}
+	return nil
+}
+
+func (x *Eddystone) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Eddystone) GetBatteryVoltage() uint32 {
+	if x != nil {
+		return x.BatteryVoltage
+	}
+	return 0
+}
+
+func (x *Eddystone) GetTemperature() float64 {
+	if x != nil && x.Temperature != nil {
+		return *x.Temperature
+	}
+	return 0
+}
+
+func (x *Eddystone) GetAdvertisementCount() uint32 {
+	if x != nil {
+		return x.AdvertisementCount
+	}
+	return 0
+}
+
+func (x *Eddystone) GetUptime() int64 {
+	if x != nil {
+		return x.Uptime
+	}
+	return 0
+}
+
+func (x *Eddystone) GetEid() []byte {
+	if x != nil {
+		return x.Eid
+	}
+	return nil
+}
+
+type Measurement struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name is the BTHome name of the object, e.g. "temperature", "humidity" or "door"
+	Name  string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value float64 `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
+	Unit  string  `protobuf:"bytes,3,opt,name=unit,proto3" json:"unit,omitempty"`
+}
+
+func (x *Measurement) Reset() {
+	*x = Measurement{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_bluetooth_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Measurement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Measurement) ProtoMessage() {}
+
+func (x *Measurement) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bluetooth_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Measurement.ProtoReflect.Descriptor instead.
+func (*Measurement) Descriptor() ([]byte, []int) {
+	return file_proto_bluetooth_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *Measurement) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Measurement) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *Measurement) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+type BTHome struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Encrypted payloads have no measurements
+	Encrypted    bool           `protobuf:"varint,1,opt,name=encrypted,proto3" json:"encrypted,omitempty"`
+	TriggerBased bool           `protobuf:"varint,2,opt,name=triggerBased,proto3" json:"triggerBased,omitempty"`
+	Measurements []*Measurement `protobuf:"bytes,3,rep,name=measurements,proto3" json:"measurements,omitempty"`
+}
+
+func (x *BTHome) Reset() {
+	*x = BTHome{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_bluetooth_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BTHome) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BTHome) ProtoMessage() {}
+
+func (x *BTHome) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bluetooth_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BTHome.ProtoReflect.Descriptor instead.
+func (*BTHome) Descriptor() ([]byte, []int) {
+	return file_proto_bluetooth_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *BTHome) GetEncrypted() bool {
+	if x != nil {
+		return x.Encrypted
+	}
+	return false
+}
+
+func (x *BTHome) GetTriggerBased() bool {
+	if x != nil {
+		return x.TriggerBased
+	}
+	return false
+}
+
+func (x *BTHome) GetMeasurements() []*Measurement {
+	if x != nil {
+		return x.Measurements
+	}
+	return nil
+}
+
+type Advertisement struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address   string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	AdapterId string   `protobuf:"bytes,2,opt,name=adapterId,proto3" json:"adapterId,omitempty"`
+	Name      string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Rssi      int32    `protobuf:"varint,4,opt,name=rssi,proto3" json:"rssi,omitempty"`
+	Uuids     []string `protobuf:"bytes,5,rep,name=uuids,proto3" json:"uuids,omitempty"`
+	// manufacturerData is keyed by company id
+	ManufacturerData map[uint32][]byte `protobuf:"bytes,6,rep,name=manufacturerData,proto3" json:"manufacturerData,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// serviceData is keyed by full service UUID
+	ServiceData map[string][]byte `protobuf:"bytes,7,rep,name=serviceData,proto3" json:"serviceData,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The decoded payloads, unset if the advertisement does not carry them
+	Ibeacon   *IBeacon   `protobuf:"bytes,8,opt,name=ibeacon,proto3" json:"ibeacon,omitempty"`
+	Eddystone *Eddystone `protobuf:"bytes,9,opt,name=eddystone,proto3" json:"eddystone,omitempty"`
+	Bthome    *BTHome    `protobuf:"bytes,10,opt,name=bthome,proto3" json:"bthome,omitempty"`
+}
+
+func (x *Advertisement) Reset() {
+	*x = Advertisement{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_bluetooth_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Advertisement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Advertisement) ProtoMessage() {}
+
+func (x *Advertisement) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bluetooth_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Advertisement.ProtoReflect.Descriptor instead.
+func (*Advertisement) Descriptor() ([]byte, []int) {
+	return file_proto_bluetooth_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *Advertisement) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Advertisement) GetAdapterId() string {
+	if x != nil {
+		return x.AdapterId
+	}
+	return ""
+}
+
+func (x *Advertisement) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Advertisement) GetRssi() int32 {
+	if x != nil {
+		return x.Rssi
+	}
+	return 0
+}
+
+func (x *Advertisement) GetUuids() []string {
+	if x != nil {
+		return x.Uuids
+	}
+	return nil
+}
+
+func (x *Advertisement) GetManufacturerData() map[uint32][]byte {
+	if x != nil {
+		return x.ManufacturerData
+	}
+	return nil
+}
+
+func (x *Advertisement) GetServiceData() map[string][]byte {
+	if x != nil {
+		return x.ServiceData
+	}
+	return nil
+}
+
+func (x *Advertisement) GetIbeacon() *IBeacon {
+	if x != nil {
+		return x.Ibeacon
+	}
+	return nil
+}
+
+func (x *Advertisement) GetEddystone() *Eddystone {
+	if x != nil {
+		return x.Eddystone
+	}
+	return nil
+}
+
+func (x *Advertisement) GetBthome() *BTHome {
+	if x != nil {
+		return x.Bthome
+	}
+	return nil
+}
+
+var File_proto_bluetooth_proto protoreflect.FileDescriptor
+
+var file_proto_bluetooth_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x62, 0x6c, 0x75, 0x65, 0x74, 0x6f, 0x6f, 0x74,
+	0x68, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04, 0x67, 0x72, 0x70, 0x63, 0x22, 0x8e, 0x05,
+	0x0a, 0x06, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x69, 0x72, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x70, 0x61, 0x69, 0x72, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x28, 0x0a, 0x0d, 0x62, 0x61, 0x74, 0x74, 0x65, 0x72,
+	0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18,
+	0x01, 0x52, 0x0d, 0x62, 0x61, 0x74, 0x74, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x12, 0x0a, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x69, 0x63, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c,
+	0x61, 0x73, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73,
+	0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x70, 0x70, 0x65, 0x61, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x61, 0x70, 0x70, 0x65, 0x61, 0x72, 0x61, 0x6e, 0x63, 0x65,
+	0x12, 0x17, 0x0a, 0x04, 0x72, 0x73, 0x73, 0x69, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00,
+	0x52, 0x04, 0x72, 0x73, 0x73, 0x69, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x74, 0x78, 0x50,
+	0x6f, 0x77, 0x65, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x48, 0x01, 0x52, 0x07, 0x74, 0x78,
+	0x50, 0x6f, 0x77, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x75, 0x69, 0x64,
+	0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x75, 0x75, 0x69, 0x64, 0x73, 0x12, 0x29,
+	0x0a, 0x08, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0d, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52,
+	0x08, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x65, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x65, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x50, 0x61, 0x69,
+	0x72, 0x69, 0x6e, 0x67, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x6c, 0x65, 0x67, 0x61,
+	0x63, 0x79, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x2a, 0x0a, 0x08, 0x6d, 0x6f, 0x64,
+	0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x67, 0x72,
+	0x70, 0x63, 0x2e, 0x4d, 0x6f, 0x64, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x52, 0x08, 0x6d, 0x6f, 0x64,
+	0x61, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72,
+	0x49, 0x64, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x07, 0x62, 0x61, 0x74, 0x74, 0x65, 0x72, 0x79, 0x18, 0x13,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x74, 0x74,
+	0x65, 0x72, 0x79, 0x52, 0x07, 0x62, 0x61, 0x74, 0x74, 0x65, 0x72, 0x79, 0x12, 0x3b, 0x0a, 0x11,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x73, 0x18, 0x14, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x11, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x72, 0x73,
+	0x73, 0x69, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x74, 0x78, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x22, 0x41,
+	0x0a, 0x07, 0x42, 0x61, 0x74, 0x74, 0x65, 0x72, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x65, 0x72,
+	0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x70,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x22, 0x31, 0x0a, 0x15, 0x42, 0x61, 0x74, 0x74, 0x65, 0x72, 0x79, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x22, 0x4d, 0x0a, 0x0d, 0x42, 0x61, 0x74, 0x74, 0x65, 0x72, 0x79, 0x53,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x61, 0x67, 0x65, 0x22, 0x90, 0x01, 0x0a, 0x0e, 0x42, 0x61, 0x74, 0x74, 0x65, 0x72, 0x79, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x2d, 0x0a, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x74, 0x74, 0x65, 0x72, 0x79,
+	0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x12,
+	0x25, 0x0a, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x54, 0x6f, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x54, 0x6f, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x88, 0x01, 0x01, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x54,
+	0x6f, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0xa9, 0x01, 0x0a, 0x0c, 0x42, 0x61, 0x74, 0x74, 0x65,
+	0x72, 0x79, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x12, 0x24, 0x0a, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x44,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x70,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x25, 0x0a, 0x0b, 0x74,
+	0x69, 0x6d, 0x65, 0x54, 0x6f, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x48, 0x00, 0x52, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x54, 0x6f, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x88,
+	0x01, 0x01, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x54, 0x6f, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x46, 0x0a, 0x10, 0x42, 0x61, 0x74, 0x74, 0x65, 0x72, 0x79, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x09, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x72, 0x70, 0x63,
+	0x2e, 0x42, 0x61, 0x74, 0x74, 0x65, 0x72, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52,
+	0x09, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x22, 0x31, 0x0a, 0x07, 0x50, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x6e, 0x0a,
+	0x08, 0x4d, 0x6f, 0x64, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x06, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x31, 0x0a,
+	0x07, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x07, 0x64, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x67, 0x72, 0x70, 0x63,
+	0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x07, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x22, 0xdf, 0x02, 0x0a, 0x0c, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x12, 0x1d, 0x0a, 0x07, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x48, 0x00, 0x52, 0x07, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x88, 0x01, 0x01,
+	0x12, 0x1b, 0x0a, 0x06, 0x70, 0x61, 0x69, 0x72, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x48, 0x01, 0x52, 0x06, 0x70, 0x61, 0x69, 0x72, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a,
+	0x09, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x48, 0x02, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x88, 0x01, 0x01,
+	0x12, 0x1d, 0x0a, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x48, 0x03, 0x52, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12,
+	0x23, 0x0a, 0x0a, 0x68, 0x61, 0x73, 0x42, 0x61, 0x74, 0x74, 0x65, 0x72, 0x79, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x48, 0x04, 0x52, 0x0a, 0x68, 0x61, 0x73, 0x42, 0x61, 0x74, 0x74, 0x65, 0x72,
+	0x79, 0x88, 0x01, 0x01, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x63, 0x6f, 0x6e,
+	0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x69, 0x63, 0x6f, 0x6e, 0x73, 0x12, 0x22,
+	0x0a, 0x0c, 0x6d, 0x61, 0x6a, 0x6f, 0x72, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x18, 0x08,
+	0x20, 0x03, 0x28, 0x0d, 0x52, 0x0c, 0x6d, 0x61, 0x6a, 0x6f, 0x72, 0x43, 0x6c, 0x61, 0x73, 0x73,
+	0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x49, 0x64, 0x73,
+	0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x49,
+	0x64, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x42, 0x09,
+	0x0a, 0x07, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x65, 0x64, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x65, 0x64, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x68, 0x61, 0x73, 0x42, 0x61, 0x74, 0x74, 0x65,
+	0x72, 0x79, 0x22, 0xd6, 0x01, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x06, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x72, 0x70, 0x63,
+	0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x37, 0x0a, 0x06, 0x73, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
+	0x53, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x52, 0x06, 0x73, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x12, 0x1e,
+	0x0a, 0x0a, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0a, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x22, 0x3b,
+	0x0a, 0x06, 0x53, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x41, 0x4d, 0x45,
+	0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x41, 0x44, 0x44, 0x52, 0x45, 0x53, 0x53, 0x10, 0x01, 0x12,
+	0x0d, 0x0a, 0x09, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0b,
+	0x0a, 0x07, 0x42, 0x41, 0x54, 0x54, 0x45, 0x52, 0x59, 0x10, 0x03, 0x22, 0x24, 0x0a, 0x08, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x22, 0x62, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x4f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x70, 0x6f, 0x77, 0x65, 0x72, 0x4f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x61, 0x70, 0x74,
+	0x65, 0x72, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x64, 0x61, 0x70,
+	0x74, 0x65, 0x72, 0x49, 0x64, 0x22, 0x4b, 0x0a, 0x11, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x49,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72,
+	0x49, 0x64, 0x22, 0x47, 0x0a, 0x0d, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a,
+	0x09, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x49, 0x64, 0x22, 0x62, 0x0a, 0x0e, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x49, 0x64, 0x22,
+	0x4c, 0x0a, 0x10, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x64, 0x44, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x24, 0x0a, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x52, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x73, 0x73,
+	0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x73, 0x73, 0x69, 0x22, 0x8a, 0x03,
+	0x0a, 0x0c, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2b,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x67,
+	0x72, 0x70, 0x63, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x24, 0x0a, 0x06, 0x64,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x67, 0x72,
+	0x70, 0x63, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x69, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x70, 0x69, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70,
+	0x61, 0x73, 0x73, 0x6b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x70, 0x61,
+	0x73, 0x73, 0x6b, 0x65, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x64,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75,
+	0x75, 0x69, 0x64, 0x22, 0xb4, 0x01, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x10,
+	0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x50, 0x49, 0x4e, 0x5f, 0x43, 0x4f, 0x44, 0x45,
+	0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x44, 0x49, 0x53, 0x50, 0x4c, 0x41, 0x59, 0x5f, 0x50, 0x49,
+	0x4e, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x52, 0x45, 0x51, 0x55,
+	0x45, 0x53, 0x54, 0x5f, 0x50, 0x41, 0x53, 0x53, 0x4b, 0x45, 0x59, 0x10, 0x02, 0x12, 0x13, 0x0a,
+	0x0f, 0x44, 0x49, 0x53, 0x50, 0x4c, 0x41, 0x59, 0x5f, 0x50, 0x41, 0x53, 0x53, 0x4b, 0x45, 0x59,
+	0x10, 0x03, 0x12, 0x18, 0x0a, 0x14, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x43, 0x4f,
+	0x4e, 0x46, 0x49, 0x52, 0x4d, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x04, 0x12, 0x19, 0x0a, 0x15,
+	0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x4f, 0x52, 0x49, 0x5a,
+	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x05, 0x12, 0x15, 0x0a, 0x11, 0x41, 0x55, 0x54, 0x48, 0x4f,
+	0x52, 0x49, 0x5a, 0x45, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x10, 0x06, 0x12, 0x0a,
+	0x0a, 0x06, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x10, 0x07, 0x22, 0x6b, 0x0a, 0x0d, 0x41, 0x67,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61,
+	0x63, 0x63, 0x65, 0x70, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x63,
+	0x65, 0x70, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x69, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x69, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x70, 0x61, 0x73, 0x73, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07,
+	0x70, 0x61, 0x73, 0x73, 0x6b, 0x65, 0x79, 0x22, 0x07, 0x0a, 0x05, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x22, 0x30, 0x0a, 0x0a, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x22, 0x33, 0x0a, 0x11, 0x50, 0x61, 0x69, 0x72, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x32, 0x0a, 0x12, 0x50, 0x61, 0x69, 0x72, 0x43,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x70, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x70, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x22, 0x49, 0x0a, 0x15, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x49,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67,
+	0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x2e, 0x0a, 0x16, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72,
+	0x6d, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x8b, 0x02, 0x0a, 0x07, 0x41, 0x64, 0x61, 0x70, 0x74,
+	0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x65,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x65, 0x64,
+	0x12, 0x22, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x62, 0x6c, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72,
+	0x61, 0x62, 0x6c, 0x65, 0x12, 0x30, 0x0a, 0x13, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72,
+	0x61, 0x62, 0x6c, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x13, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x54,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x69, 0x72, 0x61, 0x62,
+	0x6c, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x70, 0x61, 0x69, 0x72, 0x61, 0x62,
+	0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x69, 0x6e,
+	0x67, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65,
+	0x72, 0x69, 0x6e, 0x67, 0x22, 0x35, 0x0a, 0x08, 0x41, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x73,
+	0x12, 0x29, 0x0a, 0x08, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x64, 0x61, 0x70, 0x74, 0x65,
+	0x72, 0x52, 0x08, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x73, 0x22, 0x2e, 0x0a, 0x0e, 0x41,
+	0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a,
+	0x09, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x49, 0x64, 0x22, 0x4b, 0x0a, 0x11, 0x53,
+	0x65, 0x74, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64,
+	0x61, 0x70, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61,
+	0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x49, 0x64, 0x22, 0x74, 0x0a, 0x16, 0x53, 0x65, 0x74, 0x44,
+	0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x62,
+	0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76,
+	0x65, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x49, 0x64, 0x22, 0x4e,
+	0x0a, 0x12, 0x53, 0x65, 0x74, 0x50, 0x61, 0x69, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x69, 0x72, 0x61, 0x62, 0x6c, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x70, 0x61, 0x69, 0x72, 0x61, 0x62, 0x6c, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x49, 0x64, 0x22, 0x45,
+	0x0a, 0x0f, 0x53, 0x65, 0x74, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x61, 0x70, 0x74,
+	0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x64, 0x61, 0x70,
+	0x74, 0x65, 0x72, 0x49, 0x64, 0x22, 0xc2, 0x01, 0x0a, 0x06, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x12, 0x2b, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x13, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x12, 0x62, 0x6c, 0x75, 0x65, 0x74, 0x6f, 0x6f,
+	0x74, 0x68, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x12, 0x62, 0x6c, 0x75, 0x65, 0x74, 0x6f, 0x6f, 0x74, 0x68, 0x41, 0x76, 0x61, 0x69,
+	0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72,
+	0x49, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x64, 0x61, 0x70, 0x74,
+	0x65, 0x72, 0x49, 0x64, 0x73, 0x22, 0x23, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x0b, 0x0a, 0x07, 0x53, 0x45, 0x52, 0x56, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08,
+	0x44, 0x45, 0x47, 0x52, 0x41, 0x44, 0x45, 0x44, 0x10, 0x01, 0x22, 0xc7, 0x01, 0x0a, 0x05, 0x54,
+	0x72, 0x61, 0x63, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x72,
+	0x74, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x72, 0x74, 0x69,
+	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x65, 0x6e, 0x72,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x65, 0x6e, 0x72, 0x65, 0x12, 0x20,
+	0x0a, 0x0b, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x12, 0x26, 0x0a, 0x0e, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66, 0x54, 0x72, 0x61, 0x63,
+	0x6b, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x4f, 0x66, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x8d, 0x02, 0x0a, 0x0a, 0x4e, 0x6f, 0x77, 0x50, 0x6c, 0x61, 0x79,
+	0x69, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2f, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e,
+	0x67, 0x72, 0x70, 0x63, 0x2e, 0x4e, 0x6f, 0x77, 0x50, 0x6c, 0x61, 0x79, 0x69, 0x6e, 0x67, 0x2e,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x21,
+	0x0a, 0x05, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
+	0x67, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x05, 0x74, 0x72, 0x61, 0x63,
+	0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a,
+	0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x22, 0x5d, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x0b, 0x0a, 0x07, 0x53, 0x54, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07,
+	0x50, 0x4c, 0x41, 0x59, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x50, 0x41, 0x55,
+	0x53, 0x45, 0x44, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x46, 0x4f, 0x52, 0x57, 0x41, 0x52, 0x44,
+	0x5f, 0x53, 0x45, 0x45, 0x4b, 0x10, 0x03, 0x12, 0x10, 0x0a, 0x0c, 0x52, 0x45, 0x56, 0x45, 0x52,
+	0x53, 0x45, 0x5f, 0x53, 0x45, 0x45, 0x4b, 0x10, 0x04, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x52, 0x52,
+	0x4f, 0x52, 0x10, 0x05, 0x22, 0xff, 0x01, 0x0a, 0x0e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x27, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x67, 0x72, 0x70, 0x63,
+	0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x63, 0x6f, 0x64, 0x65, 0x63, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f, 0x64,
+	0x65, 0x63, 0x12, 0x1b, 0x0a, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0d, 0x48, 0x00, 0x52, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x22,
+	0x2a, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x44, 0x4c, 0x45,
+	0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12,
+	0x0a, 0x0a, 0x06, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x02, 0x42, 0x09, 0x0a, 0x07, 0x5f,
+	0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x22, 0x47, 0x0a, 0x0f, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x34, 0x0a, 0x0a, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x67, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70,
+	0x6f, 0x72, 0x74, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x22,
+	0x6c, 0x0a, 0x10, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x20, 0x0a,
+	0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x12,
+	0x1c, 0x0a, 0x09, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x49, 0x64, 0x22, 0x84, 0x01,
+	0x0a, 0x10, 0x53, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x20, 0x0a, 0x0b,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06,
+	0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65,
+	0x72, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x64, 0x61, 0x70, 0x74,
+	0x65, 0x72, 0x49, 0x64, 0x22, 0x4a, 0x0a, 0x0e, 0x47, 0x61, 0x74, 0x74, 0x44, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c,
+	0x61, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73,
+	0x22, 0x86, 0x01, 0x0a, 0x12, 0x47, 0x61, 0x74, 0x74, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74,
+	0x65, 0x72, 0x69, 0x73, 0x74, 0x69, 0x63, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x66,
+	0x6c, 0x61, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67,
+	0x73, 0x12, 0x36, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x61,
+	0x74, 0x74, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x73, 0x22, 0xa4, 0x01, 0x0a, 0x0b, 0x47, 0x61,
+	0x74, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x27, 0x0a, 0x07, 0x70, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x42, 0x0a, 0x0f,
+	0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x61, 0x74,
+	0x74, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x69, 0x73, 0x74, 0x69, 0x63, 0x52,
+	0x0f, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73,
+	0x22, 0x3d, 0x0a, 0x0c, 0x47, 0x61, 0x74, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x12, 0x2d, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x61, 0x74, 0x74, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x22,
+	0x77, 0x0a, 0x15, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x69, 0x73, 0x74, 0x69,
+	0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x69,
+	0x73, 0x74, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x68, 0x61, 0x72,
+	0x61, 0x63, 0x74, 0x65, 0x72, 0x69, 0x73, 0x74, 0x69, 0x63, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64,
+	0x61, 0x70, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61,
+	0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x49, 0x64, 0x22, 0xbc, 0x01, 0x0a, 0x1a, 0x57, 0x72, 0x69,
+	0x74, 0x65, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x69, 0x73, 0x74, 0x69, 0x63,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x69, 0x73,
+	0x74, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x68, 0x61, 0x72, 0x61,
+	0x63, 0x74, 0x65, 0x72, 0x69, 0x73, 0x74, 0x69, 0x63, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x28, 0x0a, 0x0f, 0x77, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x77, 0x69, 0x74, 0x68, 0x6f, 0x75,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x61,
+	0x70, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x64,
+	0x61, 0x70, 0x74, 0x65, 0x72, 0x49, 0x64, 0x22, 0x71, 0x0a, 0x13, 0x43, 0x68, 0x61, 0x72, 0x61,
+	0x63, 0x74, 0x65, 0x72, 0x69, 0x73, 0x74, 0x69, 0x63, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x63, 0x68, 0x61, 0x72,
+	0x61, 0x63, 0x74, 0x65, 0x72, 0x69, 0x73, 0x74, 0x69, 0x63, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x10, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x69, 0x73, 0x74,
+	0x69, 0x63, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x72, 0x0a, 0x0e, 0x4f, 0x62,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09,
+	0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x75,
+	0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x75, 0x75, 0x69, 0x64, 0x73,
+	0x12, 0x12, 0x0a, 0x04, 0x72, 0x73, 0x73, 0x69, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
+	0x72, 0x73, 0x73, 0x69, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x22, 0x63,
+	0x0a, 0x07, 0x49, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x6d, 0x61, 0x6a, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6d, 0x61,
+	0x6a, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x69, 0x6e, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x05, 0x6d, 0x69, 0x6e, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x78, 0x50,
+	0x6f, 0x77, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x74, 0x78, 0x50, 0x6f,
+	0x77, 0x65, 0x72, 0x22, 0x84, 0x03, 0x0a, 0x09, 0x45, 0x64, 0x64, 0x79, 0x73, 0x74, 0x6f, 0x6e,
+	0x65, 0x12, 0x2b, 0x0a, 0x05, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x15, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x64, 0x64, 0x79, 0x73, 0x74, 0x6f, 0x6e,
+	0x65, 0x2e, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x05, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x74, 0x78, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x07, 0x74, 0x78, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x75, 0x72, 0x6c, 0x12, 0x26, 0x0a, 0x0e, 0x62, 0x61, 0x74, 0x74, 0x65, 0x72, 0x79, 0x56,
+	0x6f, 0x6c, 0x74, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x62, 0x61,
+	0x74, 0x74, 0x65, 0x72, 0x79, 0x56, 0x6f, 0x6c, 0x74, 0x61, 0x67, 0x65, 0x12, 0x25, 0x0a, 0x0b,
+	0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x01, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x12, 0x61, 0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x12, 0x61, 0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x65,
+	0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x65, 0x69, 0x64, 0x22, 0x2b, 0x0a,
+	0x05, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x12, 0x07, 0x0a, 0x03, 0x55, 0x49, 0x44, 0x10, 0x00, 0x12,
+	0x07, 0x0a, 0x03, 0x55, 0x52, 0x4c, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x54, 0x4c, 0x4d, 0x10,
+	0x02, 0x12, 0x07, 0x0a, 0x03, 0x45, 0x49, 0x44, 0x10, 0x03, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x74,
+	0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x4b, 0x0a, 0x0b, 0x4d, 0x65,
+	0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x22, 0x81, 0x01, 0x0a, 0x06, 0x42, 0x54, 0x48, 0x6f,
+	0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64,
+	0x12, 0x22, 0x0a, 0x0c, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x42, 0x61, 0x73, 0x65, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x42,
+	0x61, 0x73, 0x65, 0x64, 0x12, 0x35, 0x0a, 0x0c, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0c, 0x6d,
+	0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xa7, 0x04, 0x0a, 0x0d,
+	0x41, 0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x61, 0x70, 0x74,
+	0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x64, 0x61, 0x70,
+	0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x73, 0x73,
+	0x69, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x73, 0x73, 0x69, 0x12, 0x14, 0x0a,
+	0x05, 0x75, 0x75, 0x69, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x75, 0x75,
+	0x69, 0x64, 0x73, 0x12, 0x55, 0x0a, 0x10, 0x6d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74, 0x75,
+	0x72, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e,
+	0x67, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x2e, 0x4d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74, 0x75, 0x72, 0x65, 0x72, 0x44,
+	0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x10, 0x6d, 0x61, 0x6e, 0x75, 0x66, 0x61,
+	0x63, 0x74, 0x75, 0x72, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x46, 0x0a, 0x0b, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x44, 0x61, 0x74, 0x61, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x24, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x44, 0x61, 0x74, 0x61,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x44, 0x61,
+	0x74, 0x61, 0x12, 0x27, 0x0a, 0x07, 0x69, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x42, 0x65, 0x61, 0x63,
+	0x6f, 0x6e, 0x52, 0x07, 0x69, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x09, 0x65,
+	0x64, 0x64, 0x79, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x64, 0x64, 0x79, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x52,
+	0x09, 0x65, 0x64, 0x64, 0x79, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x12, 0x24, 0x0a, 0x06, 0x62, 0x74,
+	0x68, 0x6f, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x42, 0x54, 0x48, 0x6f, 0x6d, 0x65, 0x52, 0x06, 0x62, 0x74, 0x68, 0x6f, 0x6d, 0x65,
+	0x1a, 0x43, 0x0a, 0x15, 0x4d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74, 0x75, 0x72, 0x65, 0x72,
+	0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3e, 0x0a, 0x10, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x32, 0xd6, 0x14, 0x0a, 0x09, 0x42, 0x6c, 0x75, 0x65, 0x74, 0x6f,
+	0x6f, 0x74, 0x68, 0x12, 0x31, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x54, 0x72, 0x75, 0x73, 0x74, 0x65,
+	0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x0b, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0d, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x18, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x0d, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x22, 0x00,
+	0x12, 0x30, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x13, 0x2e,
+	0x67, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x22, 0x00, 0x12, 0x4a, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x42, 0x61, 0x74, 0x74, 0x65, 0x72, 0x79,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1b, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x42,
+	0x61, 0x74, 0x74, 0x65, 0x72, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x74, 0x74,
+	0x65, 0x72, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x22, 0x00, 0x12, 0x39,
+	0x0a, 0x12, 0x57, 0x61, 0x74, 0x63, 0x68, 0x42, 0x61, 0x74, 0x74, 0x65, 0x72, 0x79, 0x41, 0x6c,
+	0x65, 0x72, 0x74, 0x73, 0x12, 0x0b, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x1a, 0x12, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x74, 0x74, 0x65, 0x72, 0x79,
+	0x41, 0x6c, 0x65, 0x72, 0x74, 0x22, 0x00, 0x30, 0x01, 0x12, 0x39, 0x0a, 0x0f, 0x43, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x54, 0x6f, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x14, 0x2e, 0x67,
+	0x72, 0x70, 0x63, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x14, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x46, 0x72, 0x6f, 0x6d, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x17, 0x2e, 0x67,
+	0x72, 0x70, 0x63, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x2d, 0x0a, 0x0c, 0x57, 0x61, 0x74, 0x63, 0x68,
+	0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x0b, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0c, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x38, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x14, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e,
+	0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x3b, 0x0a, 0x11, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x50, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x14, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x67, 0x72,
+	0x70, 0x63, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x32, 0x0a,
+	0x09, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x50, 0x6c, 0x61, 0x79, 0x12, 0x13, 0x2e, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x0e, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x33, 0x0a, 0x0a, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x50, 0x61, 0x75, 0x73, 0x65, 0x12,
+	0x13, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x09, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x4e,
+	0x65, 0x78, 0x74, 0x12, 0x13, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x0d, 0x4d, 0x65,
+	0x64, 0x69, 0x61, 0x50, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x12, 0x13, 0x2e, 0x67, 0x72,
+	0x70, 0x63, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x0e, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x36, 0x0a, 0x0d, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x56, 0x6f, 0x6c, 0x75, 0x6d,
+	0x65, 0x55, 0x70, 0x12, 0x13, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x0f, 0x4d, 0x65,
+	0x64, 0x69, 0x61, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x44, 0x6f, 0x77, 0x6e, 0x12, 0x13, 0x2e,
+	0x67, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x77, 0x50, 0x6c,
+	0x61, 0x79, 0x69, 0x6e, 0x67, 0x12, 0x13, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x4e, 0x6f, 0x77, 0x50, 0x6c, 0x61, 0x79, 0x69, 0x6e, 0x67, 0x22, 0x00, 0x12, 0x3c,
+	0x0a, 0x0f, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4e, 0x6f, 0x77, 0x50, 0x6c, 0x61, 0x79, 0x69, 0x6e,
+	0x67, 0x12, 0x13, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4e, 0x6f,
+	0x77, 0x50, 0x6c, 0x61, 0x79, 0x69, 0x6e, 0x67, 0x22, 0x00, 0x30, 0x01, 0x12, 0x43, 0x0a, 0x13,
+	0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f,
+	0x72, 0x74, 0x73, 0x12, 0x13, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e,
+	0x4d, 0x65, 0x64, 0x69, 0x61, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x22,
+	0x00, 0x12, 0x3b, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x16,
+	0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x65,
+	0x64, 0x69, 0x61, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x00, 0x12, 0x3b,
+	0x0a, 0x09, 0x53, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x16, 0x2e, 0x67, 0x72,
+	0x70, 0x63, 0x2e, 0x53, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x0b, 0x57,
+	0x61, 0x74, 0x63, 0x68, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x13, 0x2e, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x14, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x00, 0x30, 0x01, 0x12, 0x3d, 0x0a, 0x10, 0x4c, 0x69, 0x73,
+	0x74, 0x47, 0x61, 0x74, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x13, 0x2e,
+	0x67, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x12, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x61, 0x74, 0x74, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x12, 0x52, 0x65, 0x61, 0x64,
+	0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x69, 0x73, 0x74, 0x69, 0x63, 0x12, 0x1b,
+	0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x69,
+	0x73, 0x74, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x67, 0x72,
+	0x70, 0x63, 0x2e, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x69, 0x73, 0x74, 0x69,
+	0x63, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x13, 0x57, 0x72, 0x69, 0x74,
+	0x65, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x69, 0x73, 0x74, 0x69, 0x63, 0x12,
+	0x20, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x43, 0x68, 0x61, 0x72,
+	0x61, 0x63, 0x74, 0x65, 0x72, 0x69, 0x73, 0x74, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x0e, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x13, 0x57, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x61, 0x72,
+	0x61, 0x63, 0x74, 0x65, 0x72, 0x69, 0x73, 0x74, 0x69, 0x63, 0x12, 0x1b, 0x2e, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x69, 0x73, 0x74, 0x69, 0x63,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x43,
+	0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x69, 0x73, 0x74, 0x69, 0x63, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x42, 0x0a, 0x0e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x44,
+	0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x12, 0x14, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e,
+	0x41, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x64,
+	0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x37, 0x0a, 0x0d, 0x53, 0x74,
+	0x6f, 0x70, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x12, 0x14, 0x2e, 0x67, 0x72,
+	0x70, 0x63, 0x2e, 0x41, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x0e, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x15, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x41, 0x64,
+	0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x14, 0x2e, 0x67,
+	0x72, 0x70, 0x63, 0x2e, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x13, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x64, 0x76, 0x65, 0x72, 0x74,
+	0x69, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x00, 0x30, 0x01, 0x12, 0x33, 0x0a, 0x0a, 0x50,
+	0x61, 0x69, 0x72, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x13, 0x2e, 0x67, 0x72, 0x70, 0x63,
+	0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e,
+	0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x34, 0x0a, 0x0b, 0x54, 0x72, 0x75, 0x73, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x13, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x0d, 0x55, 0x6e, 0x74, 0x72, 0x75, 0x73,
+	0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x13, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x44,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x67,
+	0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x35,
+	0x0a, 0x0c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x13,
+	0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0c, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67,
+	0x41, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x13, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x67, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x12, 0x2e, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x00,
+	0x28, 0x01, 0x30, 0x01, 0x12, 0x2d, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x64, 0x61, 0x70,
+	0x74, 0x65, 0x72, 0x73, 0x12, 0x0b, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x1a, 0x0e, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72,
+	0x73, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x41, 0x64, 0x61, 0x70, 0x74, 0x65,
+	0x72, 0x12, 0x14, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x41,
+	0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x0a, 0x53, 0x65, 0x74, 0x50,
+	0x6f, 0x77, 0x65, 0x72, 0x65, 0x64, 0x12, 0x17, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65,
+	0x74, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x0d, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x22, 0x00,
+	0x12, 0x40, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x61,
+	0x62, 0x6c, 0x65, 0x12, 0x1c, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x74, 0x44, 0x69,
+	0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x0d, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72,
+	0x22, 0x00, 0x12, 0x38, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x50, 0x61, 0x69, 0x72, 0x61, 0x62, 0x6c,
+	0x65, 0x12, 0x18, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x74, 0x50, 0x61, 0x69, 0x72,
+	0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x67, 0x72,
+	0x70, 0x63, 0x2e, 0x41, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x08,
+	0x53, 0x65, 0x74, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x15, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e,
+	0x53, 0x65, 0x74, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x0d, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x22, 0x00,
+	0x12, 0x30, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x0b, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x10,
+	0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f,
+	0x22, 0x00, 0x12, 0x28, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12,
+	0x0b, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0c, 0x2e, 0x67,
+	0x72, 0x70, 0x63, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x0a,
+	0x50, 0x61, 0x69, 0x72, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x17, 0x2e, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x43,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x4d, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x50, 0x61, 0x69, 0x72, 0x69, 0x6e,
+	0x67, 0x12, 0x1b, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d,
+	0x50, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c,
+	0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x50, 0x61, 0x69,
+	0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x10,
+	0x5a, 0x0e, 0x62, 0x6c, 0x75, 0x65, 0x74, 0x6f, 0x6f, 0x74, 0x68, 0x2f, 0x67, 0x72, 0x70, 0x63,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_bluetooth_proto_rawDescOnce sync.Once
+	file_proto_bluetooth_proto_rawDescData = file_proto_bluetooth_proto_rawDesc
+)
+
+func file_proto_bluetooth_proto_rawDescGZIP() []byte {
+	file_proto_bluetooth_proto_rawDescOnce.Do(func() {
+		file_proto_bluetooth_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_bluetooth_proto_rawDescData)
+	})
+	return file_proto_bluetooth_proto_rawDescData
+}
+
+var file_proto_bluetooth_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_proto_bluetooth_proto_msgTypes = make([]protoimpl.MessageInfo, 55)
+var file_proto_bluetooth_proto_goTypes = []interface{}{
+	(ListDevicesRequest_SortBy)(0),     // 0: grpc.ListDevicesRequest.SortBy
+	(AgentRequest_Type)(0),             // 1: grpc.AgentRequest.Type
+	(Health_Status)(0),                 // 2: grpc.Health.Status
+	(NowPlaying_Status)(0),             // 3: grpc.NowPlaying.Status
+	(MediaTransport_State)(0),          // 4: grpc.MediaTransport.State
+	(Eddystone_Frame)(0),               // 5: grpc.Eddystone.Frame
+	(*Device)(nil),                     // 6: grpc.Device
+	(*Battery)(nil),                    // 7: grpc.Battery
+	(*BatteryHistoryRequest)(nil),      // 8: grpc.BatteryHistoryRequest
+	(*BatterySample)(nil),              // 9: grpc.BatterySample
+	(*BatteryHistory)(nil),             // 10: grpc.BatteryHistory
+	(*BatteryAlert)(nil),               // 11: grpc.BatteryAlert
+	(*BatteryHistories)(nil),           // 12: grpc.BatteryHistories
+	(*Profile)(nil),                    // 13: grpc.Profile
+	(*Modalias)(nil),                   // 14: grpc.Modalias
+	(*Devices)(nil),                    // 15: grpc.Devices
+	(*DeviceFilter)(nil),               // 16: grpc.DeviceFilter
+	(*ListDevicesRequest)(nil),         // 17: grpc.ListDevicesRequest
+	(*Response)(nil),                   // 18: grpc.Response
+	(*ConnectRequest)(nil),             // 19: grpc.ConnectRequest
+	(*DisconnectRequest)(nil),          // 20: grpc.DisconnectRequest
+	(*DeviceRequest)(nil),              // 21: grpc.DeviceRequest
+	(*ProfileRequest)(nil),             // 22: grpc.ProfileRequest
+	(*DiscoveredDevice)(nil),           // 23: grpc.DiscoveredDevice
+	(*AgentRequest)(nil),               // 24: grpc.AgentRequest
+	(*AgentResponse)(nil),              // 25: grpc.AgentResponse
+	(*Empty)(nil),                      // 26: grpc.Empty
+	(*ServerInfo)(nil),                 // 27: grpc.ServerInfo
+	(*PairClientRequest)(nil),          // 28: grpc.PairClientRequest
+	(*PairClientResponse)(nil),         // 29: grpc.PairClientResponse
+	(*ConfirmPairingRequest)(nil),      // 30: grpc.ConfirmPairingRequest
+	(*ConfirmPairingResponse)(nil),     // 31: grpc.ConfirmPairingResponse
+	(*Adapter)(nil),                    // 32: grpc.Adapter
+	(*Adapters)(nil),                   // 33: grpc.Adapters
+	(*AdapterRequest)(nil),             // 34: grpc.AdapterRequest
+	(*SetPoweredRequest)(nil),          // 35: grpc.SetPoweredRequest
+	(*SetDiscoverableRequest)(nil),     // 36: grpc.SetDiscoverableRequest
+	(*SetPairableRequest)(nil),         // 37: grpc.SetPairableRequest
+	(*SetAliasRequest)(nil),            // 38: grpc.SetAliasRequest
+	(*Health)(nil),                     // 39: grpc.Health
+	(*Track)(nil),                      // 40: grpc.Track
+	(*NowPlaying)(nil),                 // 41: grpc.NowPlaying
+	(*MediaTransport)(nil),             // 42: grpc.MediaTransport
+	(*MediaTransports)(nil),            // 43: grpc.MediaTransports
+	(*TransportRequest)(nil),           // 44: grpc.TransportRequest
+	(*SetVolumeRequest)(nil),           // 45: grpc.SetVolumeRequest
+	(*GattDescriptor)(nil),             // 46: grpc.GattDescriptor
+	(*GattCharacteristic)(nil),         // 47: grpc.GattCharacteristic
+	(*GattService)(nil),                // 48: grpc.GattService
+	(*GattServices)(nil),               // 49: grpc.GattServices
+	(*CharacteristicRequest)(nil),      // 50: grpc.CharacteristicRequest
+	(*WriteCharacteristicRequest)(nil), // 51: grpc.WriteCharacteristicRequest
+	(*CharacteristicValue)(nil),        // 52: grpc.CharacteristicValue
+	(*ObserveRequest)(nil),             // 53: grpc.ObserveRequest
+	(*IBeacon)(nil),                    // 54: grpc.IBeacon
+	(*Eddystone)(nil),                  // 55: grpc.Eddystone
+	(*Measurement)(nil),                // 56: grpc.Measurement
+	(*BTHome)(nil),                     // 57: grpc.BTHome
+	(*Advertisement)(nil),              // 58: grpc.Advertisement
+	nil,                                // 59: grpc.Advertisement.ManufacturerDataEntry
+	nil,                                // 60: grpc.Advertisement.ServiceDataEntry
+}
+var file_proto_bluetooth_proto_depIdxs = []int32{
+	13, // 0: grpc.Device.profiles:type_name -> grpc.Profile
+	14, // 1: grpc.Device.modalias:type_name -> grpc.Modalias
+	7,  // 2: grpc.Device.battery:type_name -> grpc.Battery
+	13, // 3: grpc.Device.connectedProfiles:type_name -> grpc.Profile
+	9,  // 4: grpc.BatteryHistory.samples:type_name -> grpc.BatterySample
+	6,  // 5: grpc.BatteryAlert.device:type_name -> grpc.Device
+	10, // 6: grpc.BatteryHistories.histories:type_name -> grpc.BatteryHistory
+	6,  // 7: grpc.Devices.devices:type_name -> grpc.Device
+	16, // 8: grpc.ListDevicesRequest.filter:type_name -> grpc.DeviceFilter
+	0,  // 9: grpc.ListDevicesRequest.sortBy:type_name -> grpc.ListDevicesRequest.SortBy
+	6,  // 10: grpc.DiscoveredDevice.device:type_name -> grpc.Device
+	1,  // 11: grpc.AgentRequest.type:type_name -> grpc.AgentRequest.Type
+	6,  // 12: grpc.AgentRequest.device:type_name -> grpc.Device
+	32, // 13: grpc.Adapters.adapters:type_name -> grpc.Adapter
+	2,  // 14: grpc.Health.status:type_name -> grpc.Health.Status
+	3,  // 15: grpc.NowPlaying.status:type_name -> grpc.NowPlaying.Status
+	40, // 16: grpc.NowPlaying.track:type_name -> grpc.Track
+	13, // 17: grpc.MediaTransport.profile:type_name -> grpc.Profile
+	4,  // 18: grpc.MediaTransport.state:type_name -> grpc.MediaTransport.State
+	42, // 19: grpc.MediaTransports.transports:type_name -> grpc.MediaTransport
+	46, // 20: grpc.GattCharacteristic.descriptors:type_name -> grpc.GattDescriptor
+	13, // 21: grpc.GattService.profile:type_name -> grpc.Profile
+	47, // 22: grpc.GattService.characteristics:type_name -> grpc.GattCharacteristic
+	48, // 23: grpc.GattServices.services:type_name -> grpc.GattService
+	5,  // 24: grpc.Eddystone.frame:type_name -> grpc.Eddystone.Frame
+	56, // 25: grpc.BTHome.measurements:type_name -> grpc.Measurement
+	59, // 26: grpc.Advertisement.manufacturerData:type_name -> grpc.Advertisement.ManufacturerDataEntry
+	60, // 27: grpc.Advertisement.serviceData:type_name -> grpc.Advertisement.ServiceDataEntry
+	54, // 28: grpc.Advertisement.ibeacon:type_name -> grpc.IBeacon
+	55, // 29: grpc.Advertisement.eddystone:type_name -> grpc.Eddystone
+	57, // 30: grpc.Advertisement.bthome:type_name -> grpc.BTHome
+	26, // 31: grpc.Bluetooth.GetTrustedDevices:input_type -> grpc.Empty
+	17, // 32: grpc.Bluetooth.ListDevices:input_type -> grpc.ListDevicesRequest
+	21, // 33: grpc.Bluetooth.GetDevice:input_type -> grpc.DeviceRequest
+	8,  // 34: grpc.Bluetooth.GetBatteryHistory:input_type -> grpc.BatteryHistoryRequest
+	26, // 35: grpc.Bluetooth.WatchBatteryAlerts:input_type -> grpc.Empty
+	19, // 36: grpc.Bluetooth.ConnectToDevice:input_type -> grpc.ConnectRequest
+	20, // 37: grpc.Bluetooth.DisconnectFromDevice:input_type -> grpc.DisconnectRequest
+	26, // 38: grpc.Bluetooth.WatchDevices:input_type -> grpc.Empty
+	22, // 39: grpc.Bluetooth.ConnectProfile:input_type -> grpc.ProfileRequest
+	22, // 40: grpc.Bluetooth.DisconnectProfile:input_type -> grpc.ProfileRequest
+	21, // 41: grpc.Bluetooth.MediaPlay:input_type -> grpc.DeviceRequest
+	21, // 42: grpc.Bluetooth.MediaPause:input_type -> grpc.DeviceRequest
+	21, // 43: grpc.Bluetooth.MediaNext:input_type -> grpc.DeviceRequest
+	21, // 44: grpc.Bluetooth.MediaPrevious:input_type -> grpc.DeviceRequest
+	21, // 45: grpc.Bluetooth.MediaVolumeUp:input_type -> grpc.DeviceRequest
+	21, // 46: grpc.Bluetooth.MediaVolumeDown:input_type -> grpc.DeviceRequest
+	21, // 47: grpc.Bluetooth.GetNowPlaying:input_type -> grpc.DeviceRequest
+	21, // 48: grpc.Bluetooth.WatchNowPlaying:input_type -> grpc.DeviceRequest
+	21, // 49: grpc.Bluetooth.ListMediaTransports:input_type -> grpc.DeviceRequest
+	44, // 50: grpc.Bluetooth.GetVolume:input_type -> grpc.TransportRequest
+	45, // 51: grpc.Bluetooth.SetVolume:input_type -> grpc.SetVolumeRequest
+	21, // 52: grpc.Bluetooth.WatchVolume:input_type -> grpc.DeviceRequest
+	21, // 53: grpc.Bluetooth.ListGattServices:input_type -> grpc.DeviceRequest
+	50, // 54: grpc.Bluetooth.ReadCharacteristic:input_type -> grpc.CharacteristicRequest
+	51, // 55: grpc.Bluetooth.WriteCharacteristic:input_type -> grpc.WriteCharacteristicRequest
+	50, // 56: grpc.Bluetooth.WatchCharacteristic:input_type -> grpc.CharacteristicRequest
+	34, // 57: grpc.Bluetooth.StartDiscovery:input_type -> grpc.AdapterRequest
+	34, // 58: grpc.Bluetooth.StopDiscovery:input_type -> grpc.AdapterRequest
+	53, // 59: grpc.Bluetooth.ObserveAdvertisements:input_type -> grpc.ObserveRequest
+	21, // 60: grpc.Bluetooth.PairDevice:input_type -> grpc.DeviceRequest
+	21, // 61: grpc.Bluetooth.TrustDevice:input_type -> grpc.DeviceRequest
+	21, // 62: grpc.Bluetooth.UntrustDevice:input_type -> grpc.DeviceRequest
+	21, // 63: grpc.Bluetooth.RemoveDevice:input_type -> grpc.DeviceRequest
+	25, // 64: grpc.Bluetooth.PairingAgent:input_type -> grpc.AgentResponse
+	26, // 65: grpc.Bluetooth.ListAdapters:input_type -> grpc.Empty
+	34, // 66: grpc.Bluetooth.GetAdapter:input_type -> grpc.AdapterRequest
+	35, // 67: grpc.Bluetooth.SetPowered:input_type -> grpc.SetPoweredRequest
+	36, // 68: grpc.Bluetooth.SetDiscoverable:input_type -> grpc.SetDiscoverableRequest
+	37, // 69: grpc.Bluetooth.SetPairable:input_type -> grpc.SetPairableRequest
+	38, // 70: grpc.Bluetooth.SetAlias:input_type -> grpc.SetAliasRequest
+	26, // 71: grpc.Bluetooth.GetServerInfo:input_type -> grpc.Empty
+	26, // 72: grpc.Bluetooth.GetHealth:input_type -> grpc.Empty
+	28, // 73: grpc.Bluetooth.PairClient:input_type -> grpc.PairClientRequest
+	30, // 74: grpc.Bluetooth.ConfirmPairing:input_type -> grpc.ConfirmPairingRequest
+	15, // 75: grpc.Bluetooth.GetTrustedDevices:output_type -> grpc.Devices
+	15, // 76: grpc.Bluetooth.ListDevices:output_type -> grpc.Devices
+	6,  // 77: grpc.Bluetooth.GetDevice:output_type -> grpc.Device
+	12, // 78: grpc.Bluetooth.GetBatteryHistory:output_type -> grpc.BatteryHistories
+	11, // 79: grpc.Bluetooth.WatchBatteryAlerts:output_type -> grpc.BatteryAlert
+	18, // 80: grpc.Bluetooth.ConnectToDevice:output_type -> grpc.Response
+	18, // 81: grpc.Bluetooth.DisconnectFromDevice:output_type -> grpc.Response
+	6,  // 82: grpc.Bluetooth.WatchDevices:output_type -> grpc.Device
+	18, // 83: grpc.Bluetooth.ConnectProfile:output_type -> grpc.Response
+	18, // 84: grpc.Bluetooth.DisconnectProfile:output_type -> grpc.Response
+	18, // 85: grpc.Bluetooth.MediaPlay:output_type -> grpc.Response
+	18, // 86: grpc.Bluetooth.MediaPause:output_type -> grpc.Response
+	18, // 87: grpc.Bluetooth.MediaNext:output_type -> grpc.Response
+	18, // 88: grpc.Bluetooth.MediaPrevious:output_type -> grpc.Response
+	18, // 89: grpc.Bluetooth.MediaVolumeUp:output_type -> grpc.Response
+	18, // 90: grpc.Bluetooth.MediaVolumeDown:output_type -> grpc.Response
+	41, // 91: grpc.Bluetooth.GetNowPlaying:output_type -> grpc.NowPlaying
+	41, // 92: grpc.Bluetooth.WatchNowPlaying:output_type -> grpc.NowPlaying
+	43, // 93: grpc.Bluetooth.ListMediaTransports:output_type -> grpc.MediaTransports
+	42, // 94: grpc.Bluetooth.GetVolume:output_type -> grpc.MediaTransport
+	42, // 95: grpc.Bluetooth.SetVolume:output_type -> grpc.MediaTransport
+	42, // 96: grpc.Bluetooth.WatchVolume:output_type -> grpc.MediaTransport
+	49, // 97: grpc.Bluetooth.ListGattServices:output_type -> grpc.GattServices
+	52, // 98: grpc.Bluetooth.ReadCharacteristic:output_type -> grpc.CharacteristicValue
+	18, // 99: grpc.Bluetooth.WriteCharacteristic:output_type -> grpc.Response
+	52, // 100: grpc.Bluetooth.WatchCharacteristic:output_type -> grpc.CharacteristicValue
+	23, // 101: grpc.Bluetooth.StartDiscovery:output_type -> grpc.DiscoveredDevice
+	18, // 102: grpc.Bluetooth.StopDiscovery:output_type -> grpc.Response
+	58, // 103: grpc.Bluetooth.ObserveAdvertisements:output_type -> grpc.Advertisement
+	18, // 104: grpc.Bluetooth.PairDevice:output_type -> grpc.Response
+	18, // 105: grpc.Bluetooth.TrustDevice:output_type -> grpc.Response
+	18, // 106: grpc.Bluetooth.UntrustDevice:output_type -> grpc.Response
+	18, // 107: grpc.Bluetooth.RemoveDevice:output_type -> grpc.Response
+	24, // 108: grpc.Bluetooth.PairingAgent:output_type -> grpc.AgentRequest
+	33, // 109: grpc.Bluetooth.ListAdapters:output_type -> grpc.Adapters
+	32, // 110: grpc.Bluetooth.GetAdapter:output_type -> grpc.Adapter
+	32, // 111: grpc.Bluetooth.SetPowered:output_type -> grpc.Adapter
+	32, // 112: grpc.Bluetooth.SetDiscoverable:output_type -> grpc.Adapter
+	32, // 113: grpc.Bluetooth.SetPairable:output_type -> grpc.Adapter
+	32, // 114: grpc.Bluetooth.SetAlias:output_type -> grpc.Adapter
+	27, // 115: grpc.Bluetooth.GetServerInfo:output_type -> grpc.ServerInfo
+	39, // 116: grpc.Bluetooth.GetHealth:output_type -> grpc.Health
+	29, // 117: grpc.Bluetooth.PairClient:output_type -> grpc.PairClientResponse
+	31, // 118: grpc.Bluetooth.ConfirmPairing:output_type -> grpc.ConfirmPairingResponse
+	75, // [75:119] is the sub-list for method output_type
+	31, // [31:75] is the sub-list for method input_type
+	31, // [31:31] is the sub-list for extension type_name
+	31, // [31:31] is the sub-list for extension extendee
+	0,  // [0:31] is the sub-list for field type_name
+}
+
+func init() { file_proto_bluetooth_proto_init() }
+func file_proto_bluetooth_proto_init() {
+	if File_proto_bluetooth_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_bluetooth_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Device); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_bluetooth_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Battery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_bluetooth_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatteryHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_bluetooth_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatterySample); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_bluetooth_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatteryHistory); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_bluetooth_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatteryAlert); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_bluetooth_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatteryHistories); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_bluetooth_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Profile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_bluetooth_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Modalias); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_bluetooth_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Devices); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_bluetooth_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeviceFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_bluetooth_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDevicesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_bluetooth_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_bluetooth_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConnectRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_bluetooth_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisconnectRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_bluetooth_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeviceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_bluetooth_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProfileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_bluetooth_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiscoveredDevice); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_bluetooth_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AgentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_bluetooth_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AgentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_bluetooth_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Empty); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_bluetooth_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_bluetooth_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PairClientRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_bluetooth_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PairClientResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_bluetooth_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfirmPairingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_bluetooth_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfirmPairingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_bluetooth_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Adapter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_bluetooth_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Adapters); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_bluetooth_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdapterRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -440,8 +5199,8 @@ func file_proto_bluetooth_proto_init() {
 				return nil
 			}
 		}
-		file_proto_bluetooth_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Devices); i {
+		file_proto_bluetooth_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetPoweredRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -452,8 +5211,8 @@ func file_proto_bluetooth_proto_init() {
 				return nil
 			}
 		}
-		file_proto_bluetooth_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Response); i {
+		file_proto_bluetooth_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetDiscoverableRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -464,8 +5223,8 @@ func file_proto_bluetooth_proto_init() {
 				return nil
 			}
 		}
-		file_proto_bluetooth_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ConnectRequest); i {
+		file_proto_bluetooth_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetPairableRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -476,8 +5235,8 @@ func file_proto_bluetooth_proto_init() {
 				return nil
 			}
 		}
-		file_proto_bluetooth_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DisconnectRequest); i {
+		file_proto_bluetooth_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetAliasRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -488,8 +5247,236 @@ func file_proto_bluetooth_proto_init() {
 				return nil
 			}
 		}
-		file_proto_bluetooth_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Empty); i {
+		file_proto_bluetooth_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Health); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_bluetooth_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Track); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_bluetooth_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NowPlaying); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_bluetooth_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MediaTransport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_bluetooth_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MediaTransports); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_bluetooth_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_bluetooth_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetVolumeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_bluetooth_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GattDescriptor); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_bluetooth_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GattCharacteristic); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_bluetooth_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GattService); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_bluetooth_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GattServices); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_bluetooth_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CharacteristicRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_bluetooth_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WriteCharacteristicRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_bluetooth_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CharacteristicValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_bluetooth_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ObserveRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_bluetooth_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IBeacon); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_bluetooth_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Eddystone); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_bluetooth_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Measurement); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_bluetooth_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BTHome); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_bluetooth_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Advertisement); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -501,18 +5488,25 @@ func file_proto_bluetooth_proto_init() {
 			}
 		}
 	}
+	file_proto_bluetooth_proto_msgTypes[0].OneofWrappers = []interface{}{}
+	file_proto_bluetooth_proto_msgTypes[4].OneofWrappers = []interface{}{}
+	file_proto_bluetooth_proto_msgTypes[5].OneofWrappers = []interface{}{}
+	file_proto_bluetooth_proto_msgTypes[10].OneofWrappers = []interface{}{}
+	file_proto_bluetooth_proto_msgTypes[36].OneofWrappers = []interface{}{}
+	file_proto_bluetooth_proto_msgTypes[49].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_bluetooth_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   6,
+			NumEnums:      6,
+			NumMessages:   55,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_proto_bluetooth_proto_goTypes,
 		DependencyIndexes: file_proto_bluetooth_proto_depIdxs,
+		EnumInfos:         file_proto_bluetooth_proto_enumTypes,
 		MessageInfos:      file_proto_bluetooth_proto_msgTypes,
 	}.Build()
 	File_proto_bluetooth_proto = out.File
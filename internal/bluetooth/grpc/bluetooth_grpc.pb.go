@@ -23,8 +23,54 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type BluetoothClient interface {
 	GetTrustedDevices(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Devices, error)
+	ListDevices(ctx context.Context, in *ListDevicesRequest, opts ...grpc.CallOption) (*Devices, error)
+	GetDevice(ctx context.Context, in *DeviceRequest, opts ...grpc.CallOption) (*Device, error)
+	GetBatteryHistory(ctx context.Context, in *BatteryHistoryRequest, opts ...grpc.CallOption) (*BatteryHistories, error)
+	WatchBatteryAlerts(ctx context.Context, in *Empty, opts ...grpc.CallOption) (Bluetooth_WatchBatteryAlertsClient, error)
 	ConnectToDevice(ctx context.Context, in *ConnectRequest, opts ...grpc.CallOption) (*Response, error)
 	DisconnectFromDevice(ctx context.Context, in *DisconnectRequest, opts ...grpc.CallOption) (*Response, error)
+	WatchDevices(ctx context.Context, in *Empty, opts ...grpc.CallOption) (Bluetooth_WatchDevicesClient, error)
+	ConnectProfile(ctx context.Context, in *ProfileRequest, opts ...grpc.CallOption) (*Response, error)
+	DisconnectProfile(ctx context.Context, in *ProfileRequest, opts ...grpc.CallOption) (*Response, error)
+	MediaPlay(ctx context.Context, in *DeviceRequest, opts ...grpc.CallOption) (*Response, error)
+	MediaPause(ctx context.Context, in *DeviceRequest, opts ...grpc.CallOption) (*Response, error)
+	MediaNext(ctx context.Context, in *DeviceRequest, opts ...grpc.CallOption) (*Response, error)
+	MediaPrevious(ctx context.Context, in *DeviceRequest, opts ...grpc.CallOption) (*Response, error)
+	MediaVolumeUp(ctx context.Context, in *DeviceRequest, opts ...grpc.CallOption) (*Response, error)
+	MediaVolumeDown(ctx context.Context, in *DeviceRequest, opts ...grpc.CallOption) (*Response, error)
+	GetNowPlaying(ctx context.Context, in *DeviceRequest, opts ...grpc.CallOption) (*NowPlaying, error)
+	// WatchNowPlaying sends the current state and then every change, until the device has no player left
+	WatchNowPlaying(ctx context.Context, in *DeviceRequest, opts ...grpc.CallOption) (Bluetooth_WatchNowPlayingClient, error)
+	ListMediaTransports(ctx context.Context, in *DeviceRequest, opts ...grpc.CallOption) (*MediaTransports, error)
+	GetVolume(ctx context.Context, in *TransportRequest, opts ...grpc.CallOption) (*MediaTransport, error)
+	SetVolume(ctx context.Context, in *SetVolumeRequest, opts ...grpc.CallOption) (*MediaTransport, error)
+	// WatchVolume sends every transport of the device and then every transport whose volume changes,
+	// until the device has no transport left
+	WatchVolume(ctx context.Context, in *DeviceRequest, opts ...grpc.CallOption) (Bluetooth_WatchVolumeClient, error)
+	ListGattServices(ctx context.Context, in *DeviceRequest, opts ...grpc.CallOption) (*GattServices, error)
+	ReadCharacteristic(ctx context.Context, in *CharacteristicRequest, opts ...grpc.CallOption) (*CharacteristicValue, error)
+	WriteCharacteristic(ctx context.Context, in *WriteCharacteristicRequest, opts ...grpc.CallOption) (*Response, error)
+	// WatchCharacteristic sends every value the device notifies, until it disconnects
+	WatchCharacteristic(ctx context.Context, in *CharacteristicRequest, opts ...grpc.CallOption) (Bluetooth_WatchCharacteristicClient, error)
+	StartDiscovery(ctx context.Context, in *AdapterRequest, opts ...grpc.CallOption) (Bluetooth_StartDiscoveryClient, error)
+	StopDiscovery(ctx context.Context, in *AdapterRequest, opts ...grpc.CallOption) (*Response, error)
+	// ObserveAdvertisements sends every LE advertisement that passes the filter, until the client cancels
+	ObserveAdvertisements(ctx context.Context, in *ObserveRequest, opts ...grpc.CallOption) (Bluetooth_ObserveAdvertisementsClient, error)
+	PairDevice(ctx context.Context, in *DeviceRequest, opts ...grpc.CallOption) (*Response, error)
+	TrustDevice(ctx context.Context, in *DeviceRequest, opts ...grpc.CallOption) (*Response, error)
+	UntrustDevice(ctx context.Context, in *DeviceRequest, opts ...grpc.CallOption) (*Response, error)
+	RemoveDevice(ctx context.Context, in *DeviceRequest, opts ...grpc.CallOption) (*Response, error)
+	PairingAgent(ctx context.Context, opts ...grpc.CallOption) (Bluetooth_PairingAgentClient, error)
+	ListAdapters(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Adapters, error)
+	GetAdapter(ctx context.Context, in *AdapterRequest, opts ...grpc.CallOption) (*Adapter, error)
+	SetPowered(ctx context.Context, in *SetPoweredRequest, opts ...grpc.CallOption) (*Adapter, error)
+	SetDiscoverable(ctx context.Context, in *SetDiscoverableRequest, opts ...grpc.CallOption) (*Adapter, error)
+	SetPairable(ctx context.Context, in *SetPairableRequest, opts ...grpc.CallOption) (*Adapter, error)
+	SetAlias(ctx context.Context, in *SetAliasRequest, opts ...grpc.CallOption) (*Adapter, error)
+	GetServerInfo(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ServerInfo, error)
+	GetHealth(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Health, error)
+	PairClient(ctx context.Context, in *PairClientRequest, opts ...grpc.CallOption) (*PairClientResponse, error)
+	ConfirmPairing(ctx context.Context, in *ConfirmPairingRequest, opts ...grpc.CallOption) (*ConfirmPairingResponse, error)
 }
 
 type bluetoothClient struct {
@@ -44,110 +90,1598 @@ func (c *bluetoothClient) GetTrustedDevices(ctx context.Context, in *Empty, opts
 	return out, nil
 }
 
+func (c *bluetoothClient) ListDevices(ctx context.Context, in *ListDevicesRequest, opts ...grpc.CallOption) (*Devices, error) {
+	out := new(Devices)
+	err := c.cc.Invoke(ctx, "/grpc.Bluetooth/ListDevices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bluetoothClient) GetDevice(ctx context.Context, in *DeviceRequest, opts ...grpc.CallOption) (*Device, error) {
+	out := new(Device)
+	err := c.cc.Invoke(ctx, "/grpc.Bluetooth/GetDevice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bluetoothClient) GetBatteryHistory(ctx context.Context, in *BatteryHistoryRequest, opts ...grpc.CallOption) (*BatteryHistories, error) {
+	out := new(BatteryHistories)
+	err := c.cc.Invoke(ctx, "/grpc.Bluetooth/GetBatteryHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bluetoothClient) WatchBatteryAlerts(ctx context.Context, in *Empty, opts ...grpc.CallOption) (Bluetooth_WatchBatteryAlertsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Bluetooth_ServiceDesc.Streams[0], "/grpc.Bluetooth/WatchBatteryAlerts", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &bluetoothWatchBatteryAlertsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Bluetooth_WatchBatteryAlertsClient interface {
+	Recv() (*BatteryAlert, error)
+	grpc.ClientStream
+}
+
+type bluetoothWatchBatteryAlertsClient struct {
+	grpc.ClientStream
+}
+
+func (x *bluetoothWatchBatteryAlertsClient) Recv() (*BatteryAlert, error) {
+	m := new(BatteryAlert)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *bluetoothClient) ConnectToDevice(ctx context.Context, in *ConnectRequest, opts ...grpc.CallOption) (*Response, error) {
 	out := new(Response)
 	err := c.cc.Invoke(ctx, "/grpc.Bluetooth/ConnectToDevice", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	return out, nil
+}
+
+func (c *bluetoothClient) DisconnectFromDevice(ctx context.Context, in *DisconnectRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/grpc.Bluetooth/DisconnectFromDevice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bluetoothClient) WatchDevices(ctx context.Context, in *Empty, opts ...grpc.CallOption) (Bluetooth_WatchDevicesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Bluetooth_ServiceDesc.Streams[1], "/grpc.Bluetooth/WatchDevices", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &bluetoothWatchDevicesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Bluetooth_WatchDevicesClient interface {
+	Recv() (*Device, error)
+	grpc.ClientStream
+}
+
+type bluetoothWatchDevicesClient struct {
+	grpc.ClientStream
+}
+
+func (x *bluetoothWatchDevicesClient) Recv() (*Device, error) {
+	m := new(Device)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *bluetoothClient) ConnectProfile(ctx context.Context, in *ProfileRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/grpc.Bluetooth/ConnectProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bluetoothClient) DisconnectProfile(ctx context.Context, in *ProfileRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/grpc.Bluetooth/DisconnectProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bluetoothClient) MediaPlay(ctx context.Context, in *DeviceRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/grpc.Bluetooth/MediaPlay", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bluetoothClient) MediaPause(ctx context.Context, in *DeviceRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/grpc.Bluetooth/MediaPause", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bluetoothClient) MediaNext(ctx context.Context, in *DeviceRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/grpc.Bluetooth/MediaNext", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bluetoothClient) MediaPrevious(ctx context.Context, in *DeviceRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/grpc.Bluetooth/MediaPrevious", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bluetoothClient) MediaVolumeUp(ctx context.Context, in *DeviceRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/grpc.Bluetooth/MediaVolumeUp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bluetoothClient) MediaVolumeDown(ctx context.Context, in *DeviceRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/grpc.Bluetooth/MediaVolumeDown", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bluetoothClient) GetNowPlaying(ctx context.Context, in *DeviceRequest, opts ...grpc.CallOption) (*NowPlaying, error) {
+	out := new(NowPlaying)
+	err := c.cc.Invoke(ctx, "/grpc.Bluetooth/GetNowPlaying", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bluetoothClient) WatchNowPlaying(ctx context.Context, in *DeviceRequest, opts ...grpc.CallOption) (Bluetooth_WatchNowPlayingClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Bluetooth_ServiceDesc.Streams[2], "/grpc.Bluetooth/WatchNowPlaying", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &bluetoothWatchNowPlayingClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Bluetooth_WatchNowPlayingClient interface {
+	Recv() (*NowPlaying, error)
+	grpc.ClientStream
+}
+
+type bluetoothWatchNowPlayingClient struct {
+	grpc.ClientStream
+}
+
+func (x *bluetoothWatchNowPlayingClient) Recv() (*NowPlaying, error) {
+	m := new(NowPlaying)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *bluetoothClient) ListMediaTransports(ctx context.Context, in *DeviceRequest, opts ...grpc.CallOption) (*MediaTransports, error) {
+	out := new(MediaTransports)
+	err := c.cc.Invoke(ctx, "/grpc.Bluetooth/ListMediaTransports", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bluetoothClient) GetVolume(ctx context.Context, in *TransportRequest, opts ...grpc.CallOption) (*MediaTransport, error) {
+	out := new(MediaTransport)
+	err := c.cc.Invoke(ctx, "/grpc.Bluetooth/GetVolume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bluetoothClient) SetVolume(ctx context.Context, in *SetVolumeRequest, opts ...grpc.CallOption) (*MediaTransport, error) {
+	out := new(MediaTransport)
+	err := c.cc.Invoke(ctx, "/grpc.Bluetooth/SetVolume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bluetoothClient) WatchVolume(ctx context.Context, in *DeviceRequest, opts ...grpc.CallOption) (Bluetooth_WatchVolumeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Bluetooth_ServiceDesc.Streams[3], "/grpc.Bluetooth/WatchVolume", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &bluetoothWatchVolumeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Bluetooth_WatchVolumeClient interface {
+	Recv() (*MediaTransport, error)
+	grpc.ClientStream
+}
+
+type bluetoothWatchVolumeClient struct {
+	grpc.ClientStream
+}
+
+func (x *bluetoothWatchVolumeClient) Recv() (*MediaTransport, error) {
+	m := new(MediaTransport)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *bluetoothClient) ListGattServices(ctx context.Context, in *DeviceRequest, opts ...grpc.CallOption) (*GattServices, error) {
+	out := new(GattServices)
+	err := c.cc.Invoke(ctx, "/grpc.Bluetooth/ListGattServices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bluetoothClient) ReadCharacteristic(ctx context.Context, in *CharacteristicRequest, opts ...grpc.CallOption) (*CharacteristicValue, error) {
+	out := new(CharacteristicValue)
+	err := c.cc.Invoke(ctx, "/grpc.Bluetooth/ReadCharacteristic", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bluetoothClient) WriteCharacteristic(ctx context.Context, in *WriteCharacteristicRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/grpc.Bluetooth/WriteCharacteristic", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bluetoothClient) WatchCharacteristic(ctx context.Context, in *CharacteristicRequest, opts ...grpc.CallOption) (Bluetooth_WatchCharacteristicClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Bluetooth_ServiceDesc.Streams[4], "/grpc.Bluetooth/WatchCharacteristic", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &bluetoothWatchCharacteristicClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Bluetooth_WatchCharacteristicClient interface {
+	Recv() (*CharacteristicValue, error)
+	grpc.ClientStream
+}
+
+type bluetoothWatchCharacteristicClient struct {
+	grpc.ClientStream
+}
+
+func (x *bluetoothWatchCharacteristicClient) Recv() (*CharacteristicValue, error) {
+	m := new(CharacteristicValue)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *bluetoothClient) StartDiscovery(ctx context.Context, in *AdapterRequest, opts ...grpc.CallOption) (Bluetooth_StartDiscoveryClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Bluetooth_ServiceDesc.Streams[5], "/grpc.Bluetooth/StartDiscovery", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &bluetoothStartDiscoveryClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Bluetooth_StartDiscoveryClient interface {
+	Recv() (*DiscoveredDevice, error)
+	grpc.ClientStream
+}
+
+type bluetoothStartDiscoveryClient struct {
+	grpc.ClientStream
+}
+
+func (x *bluetoothStartDiscoveryClient) Recv() (*DiscoveredDevice, error) {
+	m := new(DiscoveredDevice)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *bluetoothClient) StopDiscovery(ctx context.Context, in *AdapterRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/grpc.Bluetooth/StopDiscovery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bluetoothClient) ObserveAdvertisements(ctx context.Context, in *ObserveRequest, opts ...grpc.CallOption) (Bluetooth_ObserveAdvertisementsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Bluetooth_ServiceDesc.Streams[6], "/grpc.Bluetooth/ObserveAdvertisements", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &bluetoothObserveAdvertisementsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Bluetooth_ObserveAdvertisementsClient interface {
+	Recv() (*Advertisement, error)
+	grpc.ClientStream
+}
+
+type bluetoothObserveAdvertisementsClient struct {
+	grpc.ClientStream
+}
+
+func (x *bluetoothObserveAdvertisementsClient) Recv() (*Advertisement, error) {
+	m := new(Advertisement)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *bluetoothClient) PairDevice(ctx context.Context, in *DeviceRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/grpc.Bluetooth/PairDevice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bluetoothClient) TrustDevice(ctx context.Context, in *DeviceRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/grpc.Bluetooth/TrustDevice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bluetoothClient) UntrustDevice(ctx context.Context, in *DeviceRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/grpc.Bluetooth/UntrustDevice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bluetoothClient) RemoveDevice(ctx context.Context, in *DeviceRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/grpc.Bluetooth/RemoveDevice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bluetoothClient) PairingAgent(ctx context.Context, opts ...grpc.CallOption) (Bluetooth_PairingAgentClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Bluetooth_ServiceDesc.Streams[7], "/grpc.Bluetooth/PairingAgent", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &bluetoothPairingAgentClient{stream}
+	return x, nil
+}
+
+type Bluetooth_PairingAgentClient interface {
+	Send(*AgentResponse) error
+	Recv() (*AgentRequest, error)
+	grpc.ClientStream
+}
+
+type bluetoothPairingAgentClient struct {
+	grpc.ClientStream
+}
+
+func (x *bluetoothPairingAgentClient) Send(m *AgentResponse) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *bluetoothPairingAgentClient) Recv() (*AgentRequest, error) {
+	m := new(AgentRequest)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *bluetoothClient) ListAdapters(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Adapters, error) {
+	out := new(Adapters)
+	err := c.cc.Invoke(ctx, "/grpc.Bluetooth/ListAdapters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bluetoothClient) GetAdapter(ctx context.Context, in *AdapterRequest, opts ...grpc.CallOption) (*Adapter, error) {
+	out := new(Adapter)
+	err := c.cc.Invoke(ctx, "/grpc.Bluetooth/GetAdapter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bluetoothClient) SetPowered(ctx context.Context, in *SetPoweredRequest, opts ...grpc.CallOption) (*Adapter, error) {
+	out := new(Adapter)
+	err := c.cc.Invoke(ctx, "/grpc.Bluetooth/SetPowered", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bluetoothClient) SetDiscoverable(ctx context.Context, in *SetDiscoverableRequest, opts ...grpc.CallOption) (*Adapter, error) {
+	out := new(Adapter)
+	err := c.cc.Invoke(ctx, "/grpc.Bluetooth/SetDiscoverable", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bluetoothClient) SetPairable(ctx context.Context, in *SetPairableRequest, opts ...grpc.CallOption) (*Adapter, error) {
+	out := new(Adapter)
+	err := c.cc.Invoke(ctx, "/grpc.Bluetooth/SetPairable", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bluetoothClient) SetAlias(ctx context.Context, in *SetAliasRequest, opts ...grpc.CallOption) (*Adapter, error) {
+	out := new(Adapter)
+	err := c.cc.Invoke(ctx, "/grpc.Bluetooth/SetAlias", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bluetoothClient) GetServerInfo(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ServerInfo, error) {
+	out := new(ServerInfo)
+	err := c.cc.Invoke(ctx, "/grpc.Bluetooth/GetServerInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bluetoothClient) GetHealth(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Health, error) {
+	out := new(Health)
+	err := c.cc.Invoke(ctx, "/grpc.Bluetooth/GetHealth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bluetoothClient) PairClient(ctx context.Context, in *PairClientRequest, opts ...grpc.CallOption) (*PairClientResponse, error) {
+	out := new(PairClientResponse)
+	err := c.cc.Invoke(ctx, "/grpc.Bluetooth/PairClient", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bluetoothClient) ConfirmPairing(ctx context.Context, in *ConfirmPairingRequest, opts ...grpc.CallOption) (*ConfirmPairingResponse, error) {
+	out := new(ConfirmPairingResponse)
+	err := c.cc.Invoke(ctx, "/grpc.Bluetooth/ConfirmPairing", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BluetoothServer is the server API for Bluetooth service.
+// All implementations must embed UnimplementedBluetoothServer
+// for forward compatibility
+type BluetoothServer interface {
+	GetTrustedDevices(context.Context, *Empty) (*Devices, error)
+	ListDevices(context.Context, *ListDevicesRequest) (*Devices, error)
+	GetDevice(context.Context, *DeviceRequest) (*Device, error)
+	GetBatteryHistory(context.Context, *BatteryHistoryRequest) (*BatteryHistories, error)
+	WatchBatteryAlerts(*Empty, Bluetooth_WatchBatteryAlertsServer) error
+	ConnectToDevice(context.Context, *ConnectRequest) (*Response, error)
+	DisconnectFromDevice(context.Context, *DisconnectRequest) (*Response, error)
+	WatchDevices(*Empty, Bluetooth_WatchDevicesServer) error
+	ConnectProfile(context.Context, *ProfileRequest) (*Response, error)
+	DisconnectProfile(context.Context, *ProfileRequest) (*Response, error)
+	MediaPlay(context.Context, *DeviceRequest) (*Response, error)
+	MediaPause(context.Context, *DeviceRequest) (*Response, error)
+	MediaNext(context.Context, *DeviceRequest) (*Response, error)
+	MediaPrevious(context.Context, *DeviceRequest) (*Response, error)
+	MediaVolumeUp(context.Context, *DeviceRequest) (*Response, error)
+	MediaVolumeDown(context.Context, *DeviceRequest) (*Response, error)
+	GetNowPlaying(context.Context, *DeviceRequest) (*NowPlaying, error)
+	// WatchNowPlaying sends the current state and then every change, until the device has no player left
+	WatchNowPlaying(*DeviceRequest, Bluetooth_WatchNowPlayingServer) error
+	ListMediaTransports(context.Context, *DeviceRequest) (*MediaTransports, error)
+	GetVolume(context.Context, *TransportRequest) (*MediaTransport, error)
+	SetVolume(context.Context, *SetVolumeRequest) (*MediaTransport, error)
+	// WatchVolume sends every transport of the device and then every transport whose volume changes,
+	// until the device has no transport left
+	WatchVolume(*DeviceRequest, Bluetooth_WatchVolumeServer) error
+	ListGattServices(context.Context, *DeviceRequest) (*GattServices, error)
+	ReadCharacteristic(context.Context, *CharacteristicRequest) (*CharacteristicValue, error)
+	WriteCharacteristic(context.Context, *WriteCharacteristicRequest) (*Response, error)
+	// WatchCharacteristic sends every value the device notifies, until it disconnects
+	WatchCharacteristic(*CharacteristicRequest, Bluetooth_WatchCharacteristicServer) error
+	StartDiscovery(*AdapterRequest, Bluetooth_StartDiscoveryServer) error
+	StopDiscovery(context.Context, *AdapterRequest) (*Response, error)
+	// ObserveAdvertisements sends every LE advertisement that passes the filter, until the client cancels
+	ObserveAdvertisements(*ObserveRequest, Bluetooth_ObserveAdvertisementsServer) error
+	PairDevice(context.Context, *DeviceRequest) (*Response, error)
+	TrustDevice(context.Context, *DeviceRequest) (*Response, error)
+	UntrustDevice(context.Context, *DeviceRequest) (*Response, error)
+	RemoveDevice(context.Context, *DeviceRequest) (*Response, error)
+	PairingAgent(Bluetooth_PairingAgentServer) error
+	ListAdapters(context.Context, *Empty) (*Adapters, error)
+	GetAdapter(context.Context, *AdapterRequest) (*Adapter, error)
+	SetPowered(context.Context, *SetPoweredRequest) (*Adapter, error)
+	SetDiscoverable(context.Context, *SetDiscoverableRequest) (*Adapter, error)
+	SetPairable(context.Context, *SetPairableRequest) (*Adapter, error)
+	SetAlias(context.Context, *SetAliasRequest) (*Adapter, error)
+	GetServerInfo(context.Context, *Empty) (*ServerInfo, error)
+	GetHealth(context.Context, *Empty) (*Health, error)
+	PairClient(context.Context, *PairClientRequest) (*PairClientResponse, error)
+	ConfirmPairing(context.Context, *ConfirmPairingRequest) (*ConfirmPairingResponse, error)
+	mustEmbedUnimplementedBluetoothServer()
+}
+
+// UnimplementedBluetoothServer must be embedded to have forward compatible implementations.
+type UnimplementedBluetoothServer struct {
+}
+
+func (UnimplementedBluetoothServer) GetTrustedDevices(context.Context, *Empty) (*Devices, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrustedDevices not implemented")
+}
+func (UnimplementedBluetoothServer) ListDevices(context.Context, *ListDevicesRequest) (*Devices, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDevices not implemented")
+}
+func (UnimplementedBluetoothServer) GetDevice(context.Context, *DeviceRequest) (*Device, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDevice not implemented")
+}
+func (UnimplementedBluetoothServer) GetBatteryHistory(context.Context, *BatteryHistoryRequest) (*BatteryHistories, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBatteryHistory not implemented")
+}
+func (UnimplementedBluetoothServer) WatchBatteryAlerts(*Empty, Bluetooth_WatchBatteryAlertsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchBatteryAlerts not implemented")
+}
+func (UnimplementedBluetoothServer) ConnectToDevice(context.Context, *ConnectRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConnectToDevice not implemented")
+}
+func (UnimplementedBluetoothServer) DisconnectFromDevice(context.Context, *DisconnectRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisconnectFromDevice not implemented")
+}
+func (UnimplementedBluetoothServer) WatchDevices(*Empty, Bluetooth_WatchDevicesServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchDevices not implemented")
+}
+func (UnimplementedBluetoothServer) ConnectProfile(context.Context, *ProfileRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConnectProfile not implemented")
+}
+func (UnimplementedBluetoothServer) DisconnectProfile(context.Context, *ProfileRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisconnectProfile not implemented")
+}
+func (UnimplementedBluetoothServer) MediaPlay(context.Context, *DeviceRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MediaPlay not implemented")
+}
+func (UnimplementedBluetoothServer) MediaPause(context.Context, *DeviceRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MediaPause not implemented")
+}
+func (UnimplementedBluetoothServer) MediaNext(context.Context, *DeviceRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MediaNext not implemented")
+}
+func (UnimplementedBluetoothServer) MediaPrevious(context.Context, *DeviceRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MediaPrevious not implemented")
+}
+func (UnimplementedBluetoothServer) MediaVolumeUp(context.Context, *DeviceRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MediaVolumeUp not implemented")
+}
+func (UnimplementedBluetoothServer) MediaVolumeDown(context.Context, *DeviceRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MediaVolumeDown not implemented")
+}
+func (UnimplementedBluetoothServer) GetNowPlaying(context.Context, *DeviceRequest) (*NowPlaying, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNowPlaying not implemented")
+}
+func (UnimplementedBluetoothServer) WatchNowPlaying(*DeviceRequest, Bluetooth_WatchNowPlayingServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchNowPlaying not implemented")
+}
+func (UnimplementedBluetoothServer) ListMediaTransports(context.Context, *DeviceRequest) (*MediaTransports, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMediaTransports not implemented")
+}
+func (UnimplementedBluetoothServer) GetVolume(context.Context, *TransportRequest) (*MediaTransport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVolume not implemented")
+}
+func (UnimplementedBluetoothServer) SetVolume(context.Context, *SetVolumeRequest) (*MediaTransport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetVolume not implemented")
+}
+func (UnimplementedBluetoothServer) WatchVolume(*DeviceRequest, Bluetooth_WatchVolumeServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchVolume not implemented")
+}
+func (UnimplementedBluetoothServer) ListGattServices(context.Context, *DeviceRequest) (*GattServices, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGattServices not implemented")
+}
+func (UnimplementedBluetoothServer) ReadCharacteristic(context.Context, *CharacteristicRequest) (*CharacteristicValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadCharacteristic not implemented")
+}
+func (UnimplementedBluetoothServer) WriteCharacteristic(context.Context, *WriteCharacteristicRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WriteCharacteristic not implemented")
+}
+func (UnimplementedBluetoothServer) WatchCharacteristic(*CharacteristicRequest, Bluetooth_WatchCharacteristicServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchCharacteristic not implemented")
+}
+func (UnimplementedBluetoothServer) StartDiscovery(*AdapterRequest, Bluetooth_StartDiscoveryServer) error {
+	return status.Errorf(codes.Unimplemented, "method StartDiscovery not implemented")
+}
+func (UnimplementedBluetoothServer) StopDiscovery(context.Context, *AdapterRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopDiscovery not implemented")
+}
+func (UnimplementedBluetoothServer) ObserveAdvertisements(*ObserveRequest, Bluetooth_ObserveAdvertisementsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ObserveAdvertisements not implemented")
+}
+func (UnimplementedBluetoothServer) PairDevice(context.Context, *DeviceRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PairDevice not implemented")
+}
+func (UnimplementedBluetoothServer) TrustDevice(context.Context, *DeviceRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TrustDevice not implemented")
+}
+func (UnimplementedBluetoothServer) UntrustDevice(context.Context, *DeviceRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UntrustDevice not implemented")
+}
+func (UnimplementedBluetoothServer) RemoveDevice(context.Context, *DeviceRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveDevice not implemented")
+}
+func (UnimplementedBluetoothServer) PairingAgent(Bluetooth_PairingAgentServer) error {
+	return status.Errorf(codes.Unimplemented, "method PairingAgent not implemented")
+}
+func (UnimplementedBluetoothServer) ListAdapters(context.Context, *Empty) (*Adapters, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAdapters not implemented")
+}
+func (UnimplementedBluetoothServer) GetAdapter(context.Context, *AdapterRequest) (*Adapter, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAdapter not implemented")
+}
+func (UnimplementedBluetoothServer) SetPowered(context.Context, *SetPoweredRequest) (*Adapter, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPowered not implemented")
+}
+func (UnimplementedBluetoothServer) SetDiscoverable(context.Context, *SetDiscoverableRequest) (*Adapter, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDiscoverable not implemented")
+}
+func (UnimplementedBluetoothServer) SetPairable(context.Context, *SetPairableRequest) (*Adapter, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPairable not implemented")
+}
+func (UnimplementedBluetoothServer) SetAlias(context.Context, *SetAliasRequest) (*Adapter, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAlias not implemented")
+}
+func (UnimplementedBluetoothServer) GetServerInfo(context.Context, *Empty) (*ServerInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetServerInfo not implemented")
+}
+func (UnimplementedBluetoothServer) GetHealth(context.Context, *Empty) (*Health, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHealth not implemented")
+}
+func (UnimplementedBluetoothServer) PairClient(context.Context, *PairClientRequest) (*PairClientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PairClient not implemented")
+}
+func (UnimplementedBluetoothServer) ConfirmPairing(context.Context, *ConfirmPairingRequest) (*ConfirmPairingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmPairing not implemented")
+}
+func (UnimplementedBluetoothServer) mustEmbedUnimplementedBluetoothServer() {}
+
+// UnsafeBluetoothServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BluetoothServer will
+// result in compilation errors.
+type UnsafeBluetoothServer interface {
+	mustEmbedUnimplementedBluetoothServer()
+}
+
+func RegisterBluetoothServer(s grpc.ServiceRegistrar, srv BluetoothServer) {
+	s.RegisterService(&Bluetooth_ServiceDesc, srv)
+}
+
+func _Bluetooth_GetTrustedDevices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BluetoothServer).GetTrustedDevices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.Bluetooth/GetTrustedDevices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BluetoothServer).GetTrustedDevices(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bluetooth_ListDevices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDevicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BluetoothServer).ListDevices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.Bluetooth/ListDevices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BluetoothServer).ListDevices(ctx, req.(*ListDevicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bluetooth_GetDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BluetoothServer).GetDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.Bluetooth/GetDevice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BluetoothServer).GetDevice(ctx, req.(*DeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bluetooth_GetBatteryHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatteryHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BluetoothServer).GetBatteryHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.Bluetooth/GetBatteryHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BluetoothServer).GetBatteryHistory(ctx, req.(*BatteryHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bluetooth_WatchBatteryAlerts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BluetoothServer).WatchBatteryAlerts(m, &bluetoothWatchBatteryAlertsServer{stream})
+}
+
+type Bluetooth_WatchBatteryAlertsServer interface {
+	Send(*BatteryAlert) error
+	grpc.ServerStream
+}
+
+type bluetoothWatchBatteryAlertsServer struct {
+	grpc.ServerStream
+}
+
+func (x *bluetoothWatchBatteryAlertsServer) Send(m *BatteryAlert) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Bluetooth_ConnectToDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConnectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BluetoothServer).ConnectToDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.Bluetooth/ConnectToDevice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BluetoothServer).ConnectToDevice(ctx, req.(*ConnectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bluetooth_DisconnectFromDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisconnectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BluetoothServer).DisconnectFromDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.Bluetooth/DisconnectFromDevice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BluetoothServer).DisconnectFromDevice(ctx, req.(*DisconnectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bluetooth_WatchDevices_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BluetoothServer).WatchDevices(m, &bluetoothWatchDevicesServer{stream})
+}
+
+type Bluetooth_WatchDevicesServer interface {
+	Send(*Device) error
+	grpc.ServerStream
+}
+
+type bluetoothWatchDevicesServer struct {
+	grpc.ServerStream
+}
+
+func (x *bluetoothWatchDevicesServer) Send(m *Device) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Bluetooth_ConnectProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BluetoothServer).ConnectProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.Bluetooth/ConnectProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BluetoothServer).ConnectProfile(ctx, req.(*ProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bluetooth_DisconnectProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BluetoothServer).DisconnectProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.Bluetooth/DisconnectProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BluetoothServer).DisconnectProfile(ctx, req.(*ProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bluetooth_MediaPlay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BluetoothServer).MediaPlay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.Bluetooth/MediaPlay",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BluetoothServer).MediaPlay(ctx, req.(*DeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bluetooth_MediaPause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BluetoothServer).MediaPause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.Bluetooth/MediaPause",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BluetoothServer).MediaPause(ctx, req.(*DeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bluetooth_MediaNext_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BluetoothServer).MediaNext(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.Bluetooth/MediaNext",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BluetoothServer).MediaNext(ctx, req.(*DeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bluetooth_MediaPrevious_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BluetoothServer).MediaPrevious(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.Bluetooth/MediaPrevious",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BluetoothServer).MediaPrevious(ctx, req.(*DeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bluetooth_MediaVolumeUp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BluetoothServer).MediaVolumeUp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.Bluetooth/MediaVolumeUp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BluetoothServer).MediaVolumeUp(ctx, req.(*DeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bluetooth_MediaVolumeDown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BluetoothServer).MediaVolumeDown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.Bluetooth/MediaVolumeDown",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BluetoothServer).MediaVolumeDown(ctx, req.(*DeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bluetooth_GetNowPlaying_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BluetoothServer).GetNowPlaying(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.Bluetooth/GetNowPlaying",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BluetoothServer).GetNowPlaying(ctx, req.(*DeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bluetooth_WatchNowPlaying_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DeviceRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BluetoothServer).WatchNowPlaying(m, &bluetoothWatchNowPlayingServer{stream})
+}
+
+type Bluetooth_WatchNowPlayingServer interface {
+	Send(*NowPlaying) error
+	grpc.ServerStream
+}
+
+type bluetoothWatchNowPlayingServer struct {
+	grpc.ServerStream
+}
+
+func (x *bluetoothWatchNowPlayingServer) Send(m *NowPlaying) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Bluetooth_ListMediaTransports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BluetoothServer).ListMediaTransports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.Bluetooth/ListMediaTransports",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BluetoothServer).ListMediaTransports(ctx, req.(*DeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bluetooth_GetVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BluetoothServer).GetVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.Bluetooth/GetVolume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BluetoothServer).GetVolume(ctx, req.(*TransportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bluetooth_SetVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetVolumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BluetoothServer).SetVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.Bluetooth/SetVolume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BluetoothServer).SetVolume(ctx, req.(*SetVolumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bluetooth_WatchVolume_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DeviceRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BluetoothServer).WatchVolume(m, &bluetoothWatchVolumeServer{stream})
+}
+
+type Bluetooth_WatchVolumeServer interface {
+	Send(*MediaTransport) error
+	grpc.ServerStream
+}
+
+type bluetoothWatchVolumeServer struct {
+	grpc.ServerStream
+}
+
+func (x *bluetoothWatchVolumeServer) Send(m *MediaTransport) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Bluetooth_ListGattServices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BluetoothServer).ListGattServices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.Bluetooth/ListGattServices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BluetoothServer).ListGattServices(ctx, req.(*DeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bluetooth_ReadCharacteristic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CharacteristicRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BluetoothServer).ReadCharacteristic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.Bluetooth/ReadCharacteristic",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BluetoothServer).ReadCharacteristic(ctx, req.(*CharacteristicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bluetooth_WriteCharacteristic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WriteCharacteristicRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BluetoothServer).WriteCharacteristic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.Bluetooth/WriteCharacteristic",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BluetoothServer).WriteCharacteristic(ctx, req.(*WriteCharacteristicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bluetooth_WatchCharacteristic_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(CharacteristicRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BluetoothServer).WatchCharacteristic(m, &bluetoothWatchCharacteristicServer{stream})
+}
+
+type Bluetooth_WatchCharacteristicServer interface {
+	Send(*CharacteristicValue) error
+	grpc.ServerStream
+}
+
+type bluetoothWatchCharacteristicServer struct {
+	grpc.ServerStream
+}
+
+func (x *bluetoothWatchCharacteristicServer) Send(m *CharacteristicValue) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Bluetooth_StartDiscovery_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(AdapterRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BluetoothServer).StartDiscovery(m, &bluetoothStartDiscoveryServer{stream})
+}
+
+type Bluetooth_StartDiscoveryServer interface {
+	Send(*DiscoveredDevice) error
+	grpc.ServerStream
+}
+
+type bluetoothStartDiscoveryServer struct {
+	grpc.ServerStream
+}
+
+func (x *bluetoothStartDiscoveryServer) Send(m *DiscoveredDevice) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Bluetooth_StopDiscovery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdapterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BluetoothServer).StopDiscovery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.Bluetooth/StopDiscovery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BluetoothServer).StopDiscovery(ctx, req.(*AdapterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bluetooth_ObserveAdvertisements_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ObserveRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BluetoothServer).ObserveAdvertisements(m, &bluetoothObserveAdvertisementsServer{stream})
+}
+
+type Bluetooth_ObserveAdvertisementsServer interface {
+	Send(*Advertisement) error
+	grpc.ServerStream
+}
+
+type bluetoothObserveAdvertisementsServer struct {
+	grpc.ServerStream
+}
+
+func (x *bluetoothObserveAdvertisementsServer) Send(m *Advertisement) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Bluetooth_PairDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BluetoothServer).PairDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.Bluetooth/PairDevice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BluetoothServer).PairDevice(ctx, req.(*DeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bluetooth_TrustDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BluetoothServer).TrustDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.Bluetooth/TrustDevice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BluetoothServer).TrustDevice(ctx, req.(*DeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *bluetoothClient) DisconnectFromDevice(ctx context.Context, in *DisconnectRequest, opts ...grpc.CallOption) (*Response, error) {
-	out := new(Response)
-	err := c.cc.Invoke(ctx, "/grpc.Bluetooth/DisconnectFromDevice", in, out, opts...)
-	if err != nil {
+func _Bluetooth_UntrustDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeviceRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(BluetoothServer).UntrustDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.Bluetooth/UntrustDevice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BluetoothServer).UntrustDevice(ctx, req.(*DeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// BluetoothServer is the server API for Bluetooth service.
-// All implementations must embed UnimplementedBluetoothServer
-// for forward compatibility
-type BluetoothServer interface {
-	GetTrustedDevices(context.Context, *Empty) (*Devices, error)
-	ConnectToDevice(context.Context, *ConnectRequest) (*Response, error)
-	DisconnectFromDevice(context.Context, *DisconnectRequest) (*Response, error)
-	mustEmbedUnimplementedBluetoothServer()
+func _Bluetooth_RemoveDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BluetoothServer).RemoveDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.Bluetooth/RemoveDevice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BluetoothServer).RemoveDevice(ctx, req.(*DeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// UnimplementedBluetoothServer must be embedded to have forward compatible implementations.
-type UnimplementedBluetoothServer struct {
+func _Bluetooth_PairingAgent_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(BluetoothServer).PairingAgent(&bluetoothPairingAgentServer{stream})
 }
 
-func (UnimplementedBluetoothServer) GetTrustedDevices(context.Context, *Empty) (*Devices, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTrustedDevices not implemented")
+type Bluetooth_PairingAgentServer interface {
+	Send(*AgentRequest) error
+	Recv() (*AgentResponse, error)
+	grpc.ServerStream
 }
-func (UnimplementedBluetoothServer) ConnectToDevice(context.Context, *ConnectRequest) (*Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ConnectToDevice not implemented")
+
+type bluetoothPairingAgentServer struct {
+	grpc.ServerStream
 }
-func (UnimplementedBluetoothServer) DisconnectFromDevice(context.Context, *DisconnectRequest) (*Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DisconnectFromDevice not implemented")
+
+func (x *bluetoothPairingAgentServer) Send(m *AgentRequest) error {
+	return x.ServerStream.SendMsg(m)
 }
-func (UnimplementedBluetoothServer) mustEmbedUnimplementedBluetoothServer() {}
 
-// UnsafeBluetoothServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to BluetoothServer will
-// result in compilation errors.
-type UnsafeBluetoothServer interface {
-	mustEmbedUnimplementedBluetoothServer()
+func (x *bluetoothPairingAgentServer) Recv() (*AgentResponse, error) {
+	m := new(AgentResponse)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
 
-func RegisterBluetoothServer(s grpc.ServiceRegistrar, srv BluetoothServer) {
-	s.RegisterService(&Bluetooth_ServiceDesc, srv)
+func _Bluetooth_ListAdapters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BluetoothServer).ListAdapters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.Bluetooth/ListAdapters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BluetoothServer).ListAdapters(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Bluetooth_GetTrustedDevices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _Bluetooth_GetAdapter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdapterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BluetoothServer).GetAdapter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.Bluetooth/GetAdapter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BluetoothServer).GetAdapter(ctx, req.(*AdapterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bluetooth_SetPowered_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPoweredRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BluetoothServer).SetPowered(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.Bluetooth/SetPowered",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BluetoothServer).SetPowered(ctx, req.(*SetPoweredRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bluetooth_SetDiscoverable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDiscoverableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BluetoothServer).SetDiscoverable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.Bluetooth/SetDiscoverable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BluetoothServer).SetDiscoverable(ctx, req.(*SetDiscoverableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bluetooth_SetPairable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPairableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BluetoothServer).SetPairable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.Bluetooth/SetPairable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BluetoothServer).SetPairable(ctx, req.(*SetPairableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bluetooth_SetAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAliasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BluetoothServer).SetAlias(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.Bluetooth/SetAlias",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BluetoothServer).SetAlias(ctx, req.(*SetAliasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bluetooth_GetServerInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BluetoothServer).GetTrustedDevices(ctx, in)
+		return srv.(BluetoothServer).GetServerInfo(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/grpc.Bluetooth/GetTrustedDevices",
+		FullMethod: "/grpc.Bluetooth/GetServerInfo",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BluetoothServer).GetTrustedDevices(ctx, req.(*Empty))
+		return srv.(BluetoothServer).GetServerInfo(ctx, req.(*Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Bluetooth_ConnectToDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ConnectRequest)
+func _Bluetooth_GetHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BluetoothServer).ConnectToDevice(ctx, in)
+		return srv.(BluetoothServer).GetHealth(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/grpc.Bluetooth/ConnectToDevice",
+		FullMethod: "/grpc.Bluetooth/GetHealth",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BluetoothServer).ConnectToDevice(ctx, req.(*ConnectRequest))
+		return srv.(BluetoothServer).GetHealth(ctx, req.(*Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Bluetooth_DisconnectFromDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DisconnectRequest)
+func _Bluetooth_PairClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PairClientRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BluetoothServer).DisconnectFromDevice(ctx, in)
+		return srv.(BluetoothServer).PairClient(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/grpc.Bluetooth/DisconnectFromDevice",
+		FullMethod: "/grpc.Bluetooth/PairClient",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BluetoothServer).DisconnectFromDevice(ctx, req.(*DisconnectRequest))
+		return srv.(BluetoothServer).PairClient(ctx, req.(*PairClientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bluetooth_ConfirmPairing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmPairingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BluetoothServer).ConfirmPairing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.Bluetooth/ConfirmPairing",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BluetoothServer).ConfirmPairing(ctx, req.(*ConfirmPairingRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -163,6 +1697,18 @@ var Bluetooth_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTrustedDevices",
 			Handler:    _Bluetooth_GetTrustedDevices_Handler,
 		},
+		{
+			MethodName: "ListDevices",
+			Handler:    _Bluetooth_ListDevices_Handler,
+		},
+		{
+			MethodName: "GetDevice",
+			Handler:    _Bluetooth_GetDevice_Handler,
+		},
+		{
+			MethodName: "GetBatteryHistory",
+			Handler:    _Bluetooth_GetBatteryHistory_Handler,
+		},
 		{
 			MethodName: "ConnectToDevice",
 			Handler:    _Bluetooth_ConnectToDevice_Handler,
@@ -171,7 +1717,169 @@ var Bluetooth_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DisconnectFromDevice",
 			Handler:    _Bluetooth_DisconnectFromDevice_Handler,
 		},
+		{
+			MethodName: "ConnectProfile",
+			Handler:    _Bluetooth_ConnectProfile_Handler,
+		},
+		{
+			MethodName: "DisconnectProfile",
+			Handler:    _Bluetooth_DisconnectProfile_Handler,
+		},
+		{
+			MethodName: "MediaPlay",
+			Handler:    _Bluetooth_MediaPlay_Handler,
+		},
+		{
+			MethodName: "MediaPause",
+			Handler:    _Bluetooth_MediaPause_Handler,
+		},
+		{
+			MethodName: "MediaNext",
+			Handler:    _Bluetooth_MediaNext_Handler,
+		},
+		{
+			MethodName: "MediaPrevious",
+			Handler:    _Bluetooth_MediaPrevious_Handler,
+		},
+		{
+			MethodName: "MediaVolumeUp",
+			Handler:    _Bluetooth_MediaVolumeUp_Handler,
+		},
+		{
+			MethodName: "MediaVolumeDown",
+			Handler:    _Bluetooth_MediaVolumeDown_Handler,
+		},
+		{
+			MethodName: "GetNowPlaying",
+			Handler:    _Bluetooth_GetNowPlaying_Handler,
+		},
+		{
+			MethodName: "ListMediaTransports",
+			Handler:    _Bluetooth_ListMediaTransports_Handler,
+		},
+		{
+			MethodName: "GetVolume",
+			Handler:    _Bluetooth_GetVolume_Handler,
+		},
+		{
+			MethodName: "SetVolume",
+			Handler:    _Bluetooth_SetVolume_Handler,
+		},
+		{
+			MethodName: "ListGattServices",
+			Handler:    _Bluetooth_ListGattServices_Handler,
+		},
+		{
+			MethodName: "ReadCharacteristic",
+			Handler:    _Bluetooth_ReadCharacteristic_Handler,
+		},
+		{
+			MethodName: "WriteCharacteristic",
+			Handler:    _Bluetooth_WriteCharacteristic_Handler,
+		},
+		{
+			MethodName: "StopDiscovery",
+			Handler:    _Bluetooth_StopDiscovery_Handler,
+		},
+		{
+			MethodName: "PairDevice",
+			Handler:    _Bluetooth_PairDevice_Handler,
+		},
+		{
+			MethodName: "TrustDevice",
+			Handler:    _Bluetooth_TrustDevice_Handler,
+		},
+		{
+			MethodName: "UntrustDevice",
+			Handler:    _Bluetooth_UntrustDevice_Handler,
+		},
+		{
+			MethodName: "RemoveDevice",
+			Handler:    _Bluetooth_RemoveDevice_Handler,
+		},
+		{
+			MethodName: "ListAdapters",
+			Handler:    _Bluetooth_ListAdapters_Handler,
+		},
+		{
+			MethodName: "GetAdapter",
+			Handler:    _Bluetooth_GetAdapter_Handler,
+		},
+		{
+			MethodName: "SetPowered",
+			Handler:    _Bluetooth_SetPowered_Handler,
+		},
+		{
+			MethodName: "SetDiscoverable",
+			Handler:    _Bluetooth_SetDiscoverable_Handler,
+		},
+		{
+			MethodName: "SetPairable",
+			Handler:    _Bluetooth_SetPairable_Handler,
+		},
+		{
+			MethodName: "SetAlias",
+			Handler:    _Bluetooth_SetAlias_Handler,
+		},
+		{
+			MethodName: "GetServerInfo",
+			Handler:    _Bluetooth_GetServerInfo_Handler,
+		},
+		{
+			MethodName: "GetHealth",
+			Handler:    _Bluetooth_GetHealth_Handler,
+		},
+		{
+			MethodName: "PairClient",
+			Handler:    _Bluetooth_PairClient_Handler,
+		},
+		{
+			MethodName: "ConfirmPairing",
+			Handler:    _Bluetooth_ConfirmPairing_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchBatteryAlerts",
+			Handler:       _Bluetooth_WatchBatteryAlerts_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchDevices",
+			Handler:       _Bluetooth_WatchDevices_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchNowPlaying",
+			Handler:       _Bluetooth_WatchNowPlaying_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchVolume",
+			Handler:       _Bluetooth_WatchVolume_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchCharacteristic",
+			Handler:       _Bluetooth_WatchCharacteristic_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StartDiscovery",
+			Handler:       _Bluetooth_StartDiscovery_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ObserveAdvertisements",
+			Handler:       _Bluetooth_ObserveAdvertisements_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "PairingAgent",
+			Handler:       _Bluetooth_PairingAgent_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/bluetooth.proto",
 }
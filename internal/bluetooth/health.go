@@ -0,0 +1,24 @@
+package bluetooth
+
+import (
+	"context"
+
+	btgrpc "github.com/andree-bjorkgard/remote-bluetooth/internal/bluetooth/grpc"
+)
+
+// GetHealth reports whether Bluetooth is usable, it needs no authentication so it can be monitored
+func (s *BluetoothServer) GetHealth(_ context.Context, _ *btgrpc.Empty) (*btgrpc.Health, error) {
+	available, ids := s.adapters.status()
+
+	health := &btgrpc.Health{Status: btgrpc.Health_SERVING, BluetoothAvailable: available, AdapterIds: ids}
+	switch {
+	case !available:
+		health.Status = btgrpc.Health_DEGRADED
+		health.Reason = "bluetooth service is not running"
+	case len(ids) == 0:
+		health.Status = btgrpc.Health_DEGRADED
+		health.Reason = "no bluetooth adapter"
+	}
+
+	return health, nil
+}
@@ -0,0 +1,191 @@
+package bluetooth
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	btgrpc "github.com/andree-bjorkgard/remote-bluetooth/internal/bluetooth/grpc"
+)
+
+// ListDevices returns every device BlueZ knows that matches the filter
+func (s *BluetoothServer) ListDevices(ctx context.Context, request *btgrpc.ListDevicesRequest) (*btgrpc.Devices, error) {
+	return s.listDevices(ctx, "ListDevices", request)
+}
+
+func (s *BluetoothServer) listDevices(ctx context.Context, operation string, request *btgrpc.ListDevicesRequest) (*btgrpc.Devices, error) {
+	rawDevs, err := s.devices()
+	if err != nil {
+		return nil, deviceError(err, "")
+	}
+
+	// The battery is read once per device, providers may have to ask the device for it
+	var matches []listedDevice
+	for _, rd := range rawDevs {
+		ld := listedDevice{dev: rd, battery: s.battery(rd)}
+		if s.matchesFilter(ld, request.Filter) && s.allowed(ctx, operation, rd) {
+			matches = append(matches, ld)
+		}
+	}
+
+	devs := &btgrpc.Devices{}
+	for _, ld := range sortDevices(matches, request.SortBy, request.Descending) {
+		devs.Devices = append(devs.Devices, grpcDeviceWithBattery(ld.dev, ld.battery))
+	}
+
+	return devs, nil
+}
+
+// listedDevice is a device with its battery level, which is nil if unknown
+type listedDevice struct {
+	dev     Device
+	battery *btgrpc.Battery
+}
+
+func (s *BluetoothServer) matchesFilter(ld listedDevice, f *btgrpc.DeviceFilter) bool {
+	if f == nil {
+		return true
+	}
+
+	dev := ld.dev
+	flags := []struct {
+		want *bool
+		get  func() (bool, error)
+	}{
+		{f.Trusted, dev.GetTrusted},
+		{f.Paired, dev.GetPaired},
+		{f.Connected, dev.GetConnected},
+		{f.Blocked, dev.GetBlocked},
+		{f.HasBattery, func() (bool, error) { return hasBattery(dev, ld.battery), nil }},
+	}
+	for _, flag := range flags {
+		if flag.want == nil {
+			continue
+		}
+		if v, _ := flag.get(); v != *flag.want {
+			return false
+		}
+	}
+
+	if f.Name != "" {
+		name, _ := dev.GetName()
+		alias, _ := dev.GetAlias()
+		needle := strings.ToLower(f.Name)
+		if !strings.Contains(strings.ToLower(name), needle) && !strings.Contains(strings.ToLower(alias), needle) {
+			return false
+		}
+	}
+
+	if len(f.Icons) > 0 {
+		icon, _ := dev.GetIcon()
+		if !contains(f.Icons, icon) {
+			return false
+		}
+	}
+
+	if len(f.MajorClasses) > 0 {
+		class, _ := dev.GetClass()
+		if !contains(f.MajorClasses, majorClass(class)) {
+			return false
+		}
+	}
+
+	if len(f.AdapterIds) > 0 {
+		adapterID, _ := dev.GetAdapterID()
+		if !contains(f.AdapterIds, adapterID) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// majorClass extracts the major device class, bits 8-12 of the class of device
+func majorClass(class uint32) uint32 {
+	return (class >> 8) & 0x1f
+}
+
+func contains[T comparable](values []T, v T) bool {
+	for _, value := range values {
+		if value == v {
+			return true
+		}
+	}
+
+	return false
+}
+
+type sortKey struct {
+	dev       listedDevice
+	name      string
+	address   string
+	connected bool
+	battery   int
+}
+
+// sortDevices sorts by the requested key, ties are broken by name and then address
+func sortDevices(devs []listedDevice, by btgrpc.ListDevicesRequest_SortBy, descending bool) []listedDevice {
+	keys := make([]sortKey, len(devs))
+	for i, ld := range devs {
+		d := ld.dev
+		keys[i].dev = ld
+		keys[i].name, _ = d.GetAlias()
+		if keys[i].name == "" {
+			keys[i].name, _ = d.GetName()
+		}
+		keys[i].name = strings.ToLower(keys[i].name)
+		keys[i].address, _ = d.GetAddress()
+		keys[i].connected, _ = d.GetConnected()
+		keys[i].battery = -1
+		if ld.battery != nil {
+			keys[i].battery = int(ld.battery.Percentage)
+		}
+	}
+
+	compare := func(a, b sortKey) int {
+		switch by {
+		case btgrpc.ListDevicesRequest_ADDRESS:
+			return strings.Compare(a.address, b.address)
+		case btgrpc.ListDevicesRequest_CONNECTED:
+			if a.connected != b.connected {
+				if a.connected {
+					return -1
+				}
+				return 1
+			}
+		case btgrpc.ListDevicesRequest_BATTERY:
+			if a.battery != b.battery {
+				switch {
+				case a.battery < 0:
+					return 1
+				case b.battery < 0:
+					return -1
+				case a.battery < b.battery:
+					return -1
+				default:
+					return 1
+				}
+			}
+		}
+
+		if c := strings.Compare(a.name, b.name); c != 0 {
+			return c
+		}
+		return strings.Compare(a.address, b.address)
+	}
+
+	sort.SliceStable(keys, func(i, j int) bool {
+		c := compare(keys[i], keys[j])
+		if descending {
+			return c > 0
+		}
+		return c < 0
+	})
+
+	sorted := make([]listedDevice, len(keys))
+	for i, k := range keys {
+		sorted[i] = k.dev
+	}
+
+	return sorted
+}
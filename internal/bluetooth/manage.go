@@ -0,0 +1,119 @@
+package bluetooth
+
+import (
+	"context"
+
+	btgrpc "github.com/andree-bjorkgard/remote-bluetooth/internal/bluetooth/grpc"
+)
+
+// StartDiscovery scans for devices and streams every device that is seen, until the client cancels
+// or StopDiscovery is called.
+// Without an adapter id every adapter scans.
+func (s *BluetoothServer) StartDiscovery(request *btgrpc.AdapterRequest, stream btgrpc.Bluetooth_StartDiscoveryServer) error {
+	adapters, err := s.adapters.list(request.AdapterId)
+	if err != nil {
+		return deviceError(err, "")
+	}
+
+	found, unsubscribe, err := subscribeAll(adapters)
+	if err != nil {
+		return deviceError(err, "")
+	}
+	defer unsubscribe()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case d, ok := <-found:
+			if !ok {
+				return nil
+			}
+			if !s.allowed(stream.Context(), "StartDiscovery", d) {
+				continue
+			}
+
+			// BlueZ only has an RSSI for devices that are in range
+			rssi, err := d.GetRSSI()
+			if err != nil {
+				continue
+			}
+
+			if err := stream.Send(&btgrpc.DiscoveredDevice{Device: s.grpcDevice(d), Rssi: int32(rssi)}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// StopDiscovery stops the scan on the adapter, or on every adapter, and ends the StartDiscovery streams
+// of all clients using it
+func (s *BluetoothServer) StopDiscovery(_ context.Context, request *btgrpc.AdapterRequest) (*btgrpc.Response, error) {
+	adapters, err := s.adapters.list(request.AdapterId)
+	if err != nil {
+		return &btgrpc.Response{Success: false}, deviceError(err, "")
+	}
+
+	for _, a := range adapters {
+		a.scanner.stopAll()
+	}
+
+	return &btgrpc.Response{Success: true}, nil
+}
+
+func (s *BluetoothServer) PairDevice(ctx context.Context, request *btgrpc.DeviceRequest) (*btgrpc.Response, error) {
+	return s.onDevice(ctx, "PairDevice", request.AdapterId, request.Address, func(dev Device, _ Adapter) error {
+		return dev.Pair(ctx)
+	})
+}
+
+func (s *BluetoothServer) TrustDevice(ctx context.Context, request *btgrpc.DeviceRequest) (*btgrpc.Response, error) {
+	return s.onDevice(ctx, "TrustDevice", request.AdapterId, request.Address, func(dev Device, _ Adapter) error {
+		return dev.SetTrusted(true)
+	})
+}
+
+func (s *BluetoothServer) UntrustDevice(ctx context.Context, request *btgrpc.DeviceRequest) (*btgrpc.Response, error) {
+	return s.onDevice(ctx, "UntrustDevice", request.AdapterId, request.Address, func(dev Device, _ Adapter) error {
+		return dev.SetTrusted(false)
+	})
+}
+
+// RemoveDevice makes BlueZ forget the device, including its pairing
+func (s *BluetoothServer) RemoveDevice(ctx context.Context, request *btgrpc.DeviceRequest) (*btgrpc.Response, error) {
+	return s.onDevice(ctx, "RemoveDevice", request.AdapterId, request.Address, func(_ Device, adapter Adapter) error {
+		return adapter.RemoveDevice(request.Address)
+	})
+}
+
+// onDevice looks up the device, checks that the client may run operation on it and then runs fn
+func (s *BluetoothServer) onDevice(ctx context.Context, operation, adapterID, address string, fn func(dev Device, adapter Adapter) error) (*btgrpc.Response, error) {
+	resp := &btgrpc.Response{Success: false}
+	dev, adapter, err := s.device(adapterID, address)
+	if err != nil {
+		return resp, deviceError(err, address)
+	}
+	if !s.allowed(ctx, operation, dev) {
+		return resp, permissionDenied(ctx, operation, address)
+	}
+
+	if err := fn(dev, adapter); err != nil {
+		return resp, deviceError(err, address)
+	}
+
+	resp.Success = true
+	return resp, nil
+}
+
+// allowedDevice looks up the device and checks that the client may run operation on it
+func (s *BluetoothServer) allowedDevice(ctx context.Context, operation, adapterID, address string) (Device, error) {
+	dev, _, err := s.device(adapterID, address)
+	if err != nil {
+		return nil, deviceError(err, address)
+	}
+	if !s.allowed(ctx, operation, dev) {
+		return nil, permissionDenied(ctx, operation, address)
+	}
+
+	return dev, nil
+}
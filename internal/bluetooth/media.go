@@ -0,0 +1,172 @@
+package bluetooth
+
+import (
+	"errors"
+
+	"github.com/godbus/dbus/v5"
+	"github.com/muka/go-bluetooth/bluez"
+	"github.com/muka/go-bluetooth/bluez/profile/media"
+)
+
+var ErrNoMediaPlayer = errors.New("device has no media player")
+
+// MediaPlayer controls playback on a device over AVRCP.
+type MediaPlayer interface {
+	// GetName returns the name of the application playing on the device, if it reports one
+	GetName() (string, error)
+	// GetStatus returns playing, stopped, paused, forward-seek, reverse-seek or error
+	GetStatus() (string, error)
+	GetTrack() (Track, error)
+	// GetPosition returns the playback position in milliseconds
+	GetPosition() (uint32, error)
+
+	Play() error
+	Pause() error
+	Next() error
+	Previous() error
+	VolumeUp() error
+	VolumeDown() error
+
+	// WatchChanges signals on the returned channel whenever the status, track or position changes.
+	// Calling stop ends the subscription.
+	WatchChanges() (changes <-chan struct{}, stop func(), err error)
+}
+
+// Track is the metadata of the track a media player is playing
+type Track struct {
+	Title          string
+	Artist         string
+	Album          string
+	Genre          string
+	TrackNumber    uint32
+	NumberOfTracks uint32
+	// Duration is in milliseconds, 0 if unknown
+	Duration uint32
+}
+
+// GetMediaPlayer returns the player MediaControl1 points at, which BlueZ only has while AVRCP is connected
+func (d *bluezDevice) GetMediaPlayer() (MediaPlayer, error) {
+	om, err := bluez.GetObjectManager()
+	if err != nil {
+		return nil, err
+	}
+	objects, err := om.GetManagedObjects()
+	if err != nil {
+		return nil, err
+	}
+
+	c, ok := objects[d.Path()][media.MediaControl1Interface]
+	if !ok {
+		return nil, ErrNoMediaPlayer
+	}
+	connected, _ := c["Connected"].Value().(bool)
+	player, _ := c["Player"].Value().(dbus.ObjectPath)
+	if !connected || player == "" {
+		return nil, ErrNoMediaPlayer
+	}
+	if _, ok := objects[player][media.MediaPlayer1Interface]; !ok {
+		return nil, ErrNoMediaPlayer
+	}
+
+	return &bluezMediaPlayer{
+		path:    player,
+		player:  bluez.NewClient(&bluez.Config{Name: bluez.OrgBluezInterface, Iface: media.MediaPlayer1Interface, Path: player, Bus: bluez.SystemBus}),
+		control: bluez.NewClient(&bluez.Config{Name: bluez.OrgBluezInterface, Iface: media.MediaControl1Interface, Path: d.Path(), Bus: bluez.SystemBus}),
+	}, nil
+}
+
+// bluezMediaPlayer talks to MediaPlayer1 directly, since the generated MediaPlayer1 cannot decode the
+// Track dictionary. Volume is only on MediaControl1.
+type bluezMediaPlayer struct {
+	path    dbus.ObjectPath
+	player  *bluez.Client
+	control *bluez.Client
+}
+
+var _ MediaPlayer = (*bluezMediaPlayer)(nil)
+
+func (p *bluezMediaPlayer) GetName() (string, error) {
+	v, err := p.player.GetProperty("Name")
+	if err != nil {
+		return "", err
+	}
+	name, _ := v.Value().(string)
+
+	return name, nil
+}
+
+func (p *bluezMediaPlayer) GetStatus() (string, error) {
+	v, err := p.player.GetProperty("Status")
+	if err != nil {
+		return "", err
+	}
+	status, _ := v.Value().(string)
+
+	return status, nil
+}
+
+func (p *bluezMediaPlayer) GetPosition() (uint32, error) {
+	v, err := p.player.GetProperty("Position")
+	if err != nil {
+		return 0, err
+	}
+	position, _ := v.Value().(uint32)
+
+	return position, nil
+}
+
+// GetTrack decodes the Track dictionary, in which BlueZ leaves out every field the device does not report
+func (p *bluezMediaPlayer) GetTrack() (Track, error) {
+	v, err := p.player.GetProperty("Track")
+	if err != nil {
+		return Track{}, err
+	}
+	fields, _ := v.Value().(map[string]dbus.Variant)
+
+	str := func(key string) string {
+		s, _ := fields[key].Value().(string)
+		return s
+	}
+	num := func(key string) uint32 {
+		n, _ := fields[key].Value().(uint32)
+		return n
+	}
+
+	return Track{
+		Title:          str("Title"),
+		Artist:         str("Artist"),
+		Album:          str("Album"),
+		Genre:          str("Genre"),
+		TrackNumber:    num("TrackNumber"),
+		NumberOfTracks: num("NumberOfTracks"),
+		Duration:       num("Duration"),
+	}, nil
+}
+
+func (p *bluezMediaPlayer) Play() error {
+	return p.player.Call("Play", 0).Store()
+}
+
+func (p *bluezMediaPlayer) Pause() error {
+	return p.player.Call("Pause", 0).Store()
+}
+
+func (p *bluezMediaPlayer) Next() error {
+	return p.player.Call("Next", 0).Store()
+}
+
+func (p *bluezMediaPlayer) Previous() error {
+	return p.player.Call("Previous", 0).Store()
+}
+
+func (p *bluezMediaPlayer) VolumeUp() error {
+	return p.control.Call("VolumeUp", 0).Store()
+}
+
+func (p *bluezMediaPlayer) VolumeDown() error {
+	return p.control.Call("VolumeDown", 0).Store()
+}
+
+func (p *bluezMediaPlayer) WatchChanges() (<-chan struct{}, func(), error) {
+	return watchProperties(p.path, media.MediaPlayer1Interface)
+}
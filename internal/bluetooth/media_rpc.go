@@ -0,0 +1,181 @@
+package bluetooth
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/protobuf/proto"
+
+	btgrpc "github.com/andree-bjorkgard/remote-bluetooth/internal/bluetooth/grpc"
+)
+
+// Statuses MediaPlayer1 reports, anything else is sent as stopped
+var playerStatuses = map[string]btgrpc.NowPlaying_Status{
+	"playing":      btgrpc.NowPlaying_PLAYING,
+	"paused":       btgrpc.NowPlaying_PAUSED,
+	"forward-seek": btgrpc.NowPlaying_FORWARD_SEEK,
+	"reverse-seek": btgrpc.NowPlaying_REVERSE_SEEK,
+	"error":        btgrpc.NowPlaying_ERROR,
+}
+
+func (s *BluetoothServer) MediaPlay(ctx context.Context, request *btgrpc.DeviceRequest) (*btgrpc.Response, error) {
+	return s.onPlayer(ctx, "MediaPlay", request, MediaPlayer.Play)
+}
+
+func (s *BluetoothServer) MediaPause(ctx context.Context, request *btgrpc.DeviceRequest) (*btgrpc.Response, error) {
+	return s.onPlayer(ctx, "MediaPause", request, MediaPlayer.Pause)
+}
+
+func (s *BluetoothServer) MediaNext(ctx context.Context, request *btgrpc.DeviceRequest) (*btgrpc.Response, error) {
+	return s.onPlayer(ctx, "MediaNext", request, MediaPlayer.Next)
+}
+
+func (s *BluetoothServer) MediaPrevious(ctx context.Context, request *btgrpc.DeviceRequest) (*btgrpc.Response, error) {
+	return s.onPlayer(ctx, "MediaPrevious", request, MediaPlayer.Previous)
+}
+
+func (s *BluetoothServer) MediaVolumeUp(ctx context.Context, request *btgrpc.DeviceRequest) (*btgrpc.Response, error) {
+	return s.onPlayer(ctx, "MediaVolumeUp", request, MediaPlayer.VolumeUp)
+}
+
+func (s *BluetoothServer) MediaVolumeDown(ctx context.Context, request *btgrpc.DeviceRequest) (*btgrpc.Response, error) {
+	return s.onPlayer(ctx, "MediaVolumeDown", request, MediaPlayer.VolumeDown)
+}
+
+func (s *BluetoothServer) GetNowPlaying(ctx context.Context, request *btgrpc.DeviceRequest) (*btgrpc.NowPlaying, error) {
+	_, player, err := s.mediaPlayer(ctx, "GetNowPlaying", request)
+	if err != nil {
+		return nil, err
+	}
+
+	np, err := nowPlaying(request.Address, player)
+	if err != nil {
+		return nil, deviceError(err, request.Address)
+	}
+
+	return np, nil
+}
+
+// WatchNowPlaying follows the player of the device. The device itself changes when AVRCP disconnects or
+// the device switches to another player, so the player is looked up again every time it does.
+func (s *BluetoothServer) WatchNowPlaying(request *btgrpc.DeviceRequest, stream btgrpc.Bluetooth_WatchNowPlayingServer) error {
+	ctx := stream.Context()
+	dev, player, err := s.mediaPlayer(ctx, "WatchNowPlaying", request)
+	if err != nil {
+		return err
+	}
+
+	devChanges, stopDev, err := dev.WatchChanges()
+	if err != nil {
+		return deviceError(err, request.Address)
+	}
+	defer stopDev()
+
+	var last *btgrpc.NowPlaying
+	send := func() error {
+		np, err := nowPlaying(request.Address, player)
+		if err != nil {
+			return deviceError(err, request.Address)
+		}
+		// Device changes that do not touch the player would otherwise repeat the same state
+		if proto.Equal(np, last) {
+			return nil
+		}
+		last = np
+
+		return stream.Send(np)
+	}
+
+	for {
+		changes, stop, err := player.WatchChanges()
+		if err != nil {
+			return deviceError(err, request.Address)
+		}
+		err = follow(ctx, changes, devChanges, send)
+		stop()
+		if err != nil || ctx.Err() != nil {
+			return err
+		}
+
+		player, err = dev.GetMediaPlayer()
+		if errors.Is(err, ErrNoMediaPlayer) {
+			return nil
+		}
+		if err != nil {
+			return deviceError(err, request.Address)
+		}
+	}
+}
+
+// follow calls send right away and then every time changes signals, until the device changes
+func follow(ctx context.Context, changes, devChanges <-chan struct{}, send func() error) error {
+	for {
+		if err := send(); err != nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-devChanges:
+			return nil
+		case <-changes:
+		}
+	}
+}
+
+// onPlayer runs fn on the media player of the device, like onDevice does on the device
+func (s *BluetoothServer) onPlayer(ctx context.Context, operation string, request *btgrpc.DeviceRequest, fn func(p MediaPlayer) error) (*btgrpc.Response, error) {
+	return s.onDevice(ctx, operation, request.AdapterId, request.Address, func(dev Device, _ Adapter) error {
+		player, err := dev.GetMediaPlayer()
+		if err != nil {
+			return err
+		}
+
+		return fn(player)
+	})
+}
+
+// mediaPlayer looks up the device, checks that the client may run operation on it and returns its player
+func (s *BluetoothServer) mediaPlayer(ctx context.Context, operation string, request *btgrpc.DeviceRequest) (Device, MediaPlayer, error) {
+	dev, err := s.allowedDevice(ctx, operation, request.AdapterId, request.Address)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	player, err := dev.GetMediaPlayer()
+	if err != nil {
+		return nil, nil, deviceError(err, request.Address)
+	}
+
+	return dev, player, nil
+}
+
+func nowPlaying(address string, player MediaPlayer) (*btgrpc.NowPlaying, error) {
+	status, err := player.GetStatus()
+	if err != nil {
+		return nil, err
+	}
+	track, err := player.GetTrack()
+	if err != nil {
+		return nil, err
+	}
+	position, _ := player.GetPosition()
+	name, _ := player.GetName()
+
+	return &btgrpc.NowPlaying{
+		Address: address,
+		Status:  playerStatuses[status],
+		Track: &btgrpc.Track{
+			Title:          track.Title,
+			Artist:         track.Artist,
+			Album:          track.Album,
+			Genre:          track.Genre,
+			TrackNumber:    track.TrackNumber,
+			NumberOfTracks: track.NumberOfTracks,
+			Duration:       track.Duration,
+		},
+		Position: position,
+		Player:   name,
+	}, nil
+}
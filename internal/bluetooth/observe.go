@@ -0,0 +1,255 @@
+package bluetooth
+
+import (
+	"log"
+	"strings"
+	"sync"
+
+	"github.com/godbus/dbus/v5"
+	"github.com/muka/go-bluetooth/bluez"
+	"github.com/muka/go-bluetooth/bluez/profile/adapter"
+	"github.com/muka/go-bluetooth/bluez/profile/device"
+)
+
+// Advertisement is what a device broadcasts. BlueZ only reports what changed since the last
+// advertisement, so the data is what the device advertised most recently.
+type Advertisement struct {
+	Address string
+	Name    string
+	RSSI    int16
+	// UUIDs are the advertised service UUIDs
+	UUIDs            []string
+	ManufacturerData map[uint16][]byte
+	// ServiceData is keyed by the full lowercase service UUID
+	ServiceData map[string][]byte
+}
+
+// ObserveFilter narrows the advertisements that are observed, the zero filter observes every LE device
+type ObserveFilter struct {
+	// UUIDs are service UUIDs, the device must advertise one of them or have service data for it. Adapters
+	// get full lowercase UUIDs, clients may also send short UUIDs like feaa and profile names.
+	UUIDs []string
+	// RSSI is the lowest RSSI that is observed, 0 for any
+	RSSI int16
+	// Pattern is a prefix of the address or name of the device
+	Pattern string
+}
+
+// Matches reports whether the advertisement passes the filter. BlueZ merges the filters of all of its
+// discovery clients, so advertisements that do not pass are still received.
+func (f ObserveFilter) Matches(ad Advertisement) bool {
+	if f.RSSI != 0 && ad.RSSI < f.RSSI {
+		return false
+	}
+	if f.Pattern != "" && !strings.HasPrefix(ad.Address, f.Pattern) && !strings.HasPrefix(ad.Name, f.Pattern) {
+		return false
+	}
+	if len(f.UUIDs) == 0 {
+		return true
+	}
+	for _, uuid := range f.UUIDs {
+		if _, ok := ad.ServiceData[uuid]; ok || contains(ad.UUIDs, uuid) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Observe runs an LE discovery with duplicate data reporting, so that every advertisement is received and
+// not only the first one of each device. It runs on the server's D-Bus connection and shares its discovery
+// with Discover and the other observations of the adapter. The channel is closed when stop is called or the
+// adapter is removed.
+func (a *bluezAdapter) Observe(filter ObserveFilter) (<-chan Advertisement, func(), error) {
+	conn, err := bluez.GetConnection(bluez.SystemBus)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	adapterPath := a.adapter.Path()
+	matches := [][]dbus.MatchOption{
+		{
+			dbus.WithMatchPathNamespace(adapterPath),
+			dbus.WithMatchInterface(bluez.PropertiesInterface),
+			dbus.WithMatchMember("PropertiesChanged"),
+			dbus.WithMatchOption("arg0", device.Device1Interface),
+		},
+		{dbus.WithMatchInterface(bluez.ObjectManagerInterface), dbus.WithMatchMember("InterfacesAdded")},
+		{dbus.WithMatchInterface(bluez.ObjectManagerInterface), dbus.WithMatchMember("InterfacesRemoved")},
+	}
+	signals := make(chan *dbus.Signal, 50)
+	unwatch := func(added [][]dbus.MatchOption) {
+		conn.RemoveSignal(signals)
+		for _, m := range added {
+			if err := conn.RemoveMatchSignal(m...); err != nil {
+				log.Println("Error unwatching advertisements:", err)
+			}
+		}
+	}
+	for i, m := range matches {
+		if err := conn.AddMatchSignal(m...); err != nil {
+			unwatch(matches[:i])
+			return nil, nil, err
+		}
+	}
+	conn.Signal(signals)
+
+	// Devices BlueZ already knows only report what changes, so their data is the starting point
+	var objects map[dbus.ObjectPath]map[string]map[string]dbus.Variant
+	err = conn.Object(bluez.OrgBluezInterface, "/").Call(bluez.ObjectManagerInterface+".GetManagedObjects", 0).Store(&objects)
+	if err != nil {
+		unwatch(matches)
+		return nil, nil, err
+	}
+	known := make(map[dbus.ObjectPath]*Advertisement)
+	for p, ifaces := range objects {
+		if props, ok := ifaces[device.Device1Interface]; ok && isDevicePath(adapterPath, p) {
+			ad := advertisementFromPath(p)
+			updateAdvertisement(ad, props)
+			known[p] = ad
+		}
+	}
+
+	release, err := discoveryOf(a.adapter).start(&filter)
+	if err != nil {
+		unwatch(matches)
+		return nil, nil, err
+	}
+
+	ads := make(chan Advertisement, 20)
+	done := make(chan struct{})
+	stopped := make(chan struct{})
+
+	send := func(ad *Advertisement) {
+		select {
+		case ads <- *ad:
+		default:
+			// A slow client misses an advertisement rather than stalling the signals of the connection
+		}
+	}
+
+	go func() {
+		defer close(stopped)
+		defer close(ads)
+		for {
+			var sig *dbus.Signal
+			select {
+			case <-done:
+				return
+			case sig = <-signals:
+			}
+			// The connection is shared, so the signals of every match arrive here
+			if sig == nil || len(sig.Body) < 2 {
+				continue
+			}
+
+			switch sig.Name {
+			case bluez.PropertiesInterface + ".PropertiesChanged":
+				if !isDevicePath(adapterPath, sig.Path) {
+					continue
+				}
+				changed, _ := sig.Body[1].(map[string]dbus.Variant)
+				ad, ok := known[sig.Path]
+				if !ok {
+					ad = advertisementFromPath(sig.Path)
+					known[sig.Path] = ad
+				}
+				updateAdvertisement(ad, changed)
+				if isAdvertised(changed) {
+					send(ad)
+				}
+
+			case bluez.InterfacesAdded:
+				p, _ := sig.Body[0].(dbus.ObjectPath)
+				ifaces, _ := sig.Body[1].(map[string]map[string]dbus.Variant)
+				props, ok := ifaces[device.Device1Interface]
+				if !ok || !isDevicePath(adapterPath, p) {
+					continue
+				}
+				ad := advertisementFromPath(p)
+				updateAdvertisement(ad, props)
+				known[p] = ad
+				if isAdvertised(props) {
+					send(ad)
+				}
+
+			case bluez.InterfacesRemoved:
+				p, _ := sig.Body[0].(dbus.ObjectPath)
+				ifaces, _ := sig.Body[1].([]string)
+				if p == adapterPath && contains(ifaces, adapter.Adapter1Interface) {
+					return
+				}
+				delete(known, p)
+			}
+		}
+	}()
+
+	var once sync.Once
+	stop := func() {
+		once.Do(func() {
+			close(done)
+			<-stopped
+			unwatch(matches)
+			release()
+		})
+	}
+
+	return ads, stop, nil
+}
+
+// isDevicePath reports whether p is a device of the adapter, rather than the adapter or a GATT object
+func isDevicePath(adapterPath, p dbus.ObjectPath) bool {
+	rest, ok := strings.CutPrefix(string(p), string(adapterPath)+"/")
+	return ok && !strings.Contains(rest, "/")
+}
+
+// advertisementFromPath starts the advertisement of a device from its path
+func advertisementFromPath(p dbus.ObjectPath) *Advertisement {
+	return &Advertisement{Address: addressFromPath(p)}
+}
+
+// addressFromPath returns the address of a device from its path, e.g. /org/bluez/hci0/dev_AA_BB_CC_DD_EE_FF
+func addressFromPath(p dbus.ObjectPath) string {
+	name := string(p)[strings.LastIndex(string(p), "/")+1:]
+	return strings.ReplaceAll(strings.TrimPrefix(name, "dev_"), "_", ":")
+}
+
+// isAdvertised reports whether the changed properties come from an advertisement
+func isAdvertised(props map[string]dbus.Variant) bool {
+	for _, name := range []string{"RSSI", "ManufacturerData", "ServiceData"} {
+		if _, ok := props[name]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// updateAdvertisement replaces the slices and maps of the advertisement rather than changing them, so the
+// advertisements that were sent are left alone
+func updateAdvertisement(ad *Advertisement, props map[string]dbus.Variant) {
+	if v, ok := props["Address"].Value().(string); ok {
+		ad.Address = v
+	}
+	if v, ok := props["Name"].Value().(string); ok {
+		ad.Name = v
+	}
+	if v, ok := props["RSSI"].Value().(int16); ok {
+		ad.RSSI = v
+	}
+	if v, ok := props["UUIDs"].Value().([]string); ok {
+		ad.UUIDs = v
+	}
+	if v, ok := props["ManufacturerData"].Value().(map[uint16]dbus.Variant); ok {
+		ad.ManufacturerData = make(map[uint16][]byte, len(v))
+		for id, data := range v {
+			ad.ManufacturerData[id], _ = data.Value().([]byte)
+		}
+	}
+	if v, ok := props["ServiceData"].Value().(map[string]dbus.Variant); ok {
+		ad.ServiceData = make(map[string][]byte, len(v))
+		for uuid, data := range v {
+			ad.ServiceData[strings.ToLower(uuid)], _ = data.Value().([]byte)
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package bluetooth
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/andree-bjorkgard/remote-bluetooth/internal/advertisement"
+	btgrpc "github.com/andree-bjorkgard/remote-bluetooth/internal/bluetooth/grpc"
+)
+
+// ObserveAdvertisements streams the advertisements of every device in range without connecting to them,
+// until the client cancels or the adapters are gone. Without an adapter id every adapter observes.
+func (s *BluetoothServer) ObserveAdvertisements(request *btgrpc.ObserveRequest, stream btgrpc.Bluetooth_ObserveAdvertisementsServer) error {
+	ctx := stream.Context()
+
+	filter := ObserveFilter{RSSI: int16(request.Rssi), Pattern: request.Pattern}
+	for _, u := range request.Uuids {
+		uuid, err := profileUUID(strings.ToLower(strings.TrimSpace(u)))
+		if err != nil {
+			return deviceError(fmt.Errorf("%w: %q", err, u), "")
+		}
+		filter.UUIDs = append(filter.UUIDs, uuid)
+	}
+
+	adapters, err := s.adapters.list(request.AdapterId)
+	if err != nil {
+		return deviceError(err, "")
+	}
+
+	ads, stop, err := observeAll(adapters, filter)
+	if err != nil {
+		return deviceError(err, "")
+	}
+	defer stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case ad, ok := <-ads:
+			if !ok {
+				return nil
+			}
+			if !filter.Matches(ad.Advertisement) || !s.acl.Allowed(clientIdentity(ctx), "ObserveAdvertisements", ad.Address, ad.Name) {
+				continue
+			}
+
+			if err := stream.Send(grpcAdvertisement(ad.adapterID, ad.Advertisement)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// observedAdvertisement is an advertisement together with the adapter that received it
+type observedAdvertisement struct {
+	Advertisement
+	adapterID string
+}
+
+// observeAll observes with every adapter. The returned channel is closed once every observation has ended,
+// which stop or the removal of all of the adapters does.
+func observeAll(adapters []*managedAdapter, filter ObserveFilter) (<-chan observedAdvertisement, func(), error) {
+	return mergeAdapters(adapters, func(a *managedAdapter) (<-chan Advertisement, func(), error) {
+		return a.Observe(filter)
+	}, func(a *managedAdapter, ad Advertisement) observedAdvertisement {
+		return observedAdvertisement{Advertisement: ad, adapterID: a.GetID()}
+	})
+}
+
+func grpcAdvertisement(adapterID string, ad Advertisement) *btgrpc.Advertisement {
+	ga := &btgrpc.Advertisement{
+		Address:          ad.Address,
+		AdapterId:        adapterID,
+		Name:             ad.Name,
+		Rssi:             int32(ad.RSSI),
+		Uuids:            ad.UUIDs,
+		ManufacturerData: make(map[uint32][]byte, len(ad.ManufacturerData)),
+		ServiceData:      ad.ServiceData,
+	}
+	for id, data := range ad.ManufacturerData {
+		ga.ManufacturerData[uint32(id)] = data
+	}
+
+	payloads := advertisement.Decode(ad.ManufacturerData, ad.ServiceData)
+	if b := payloads.IBeacon; b != nil {
+		ga.Ibeacon = &btgrpc.IBeacon{Uuid: b.UUID, Major: uint32(b.Major), Minor: uint32(b.Minor), TxPower: int32(b.TxPower)}
+	}
+	if e := payloads.Eddystone; e != nil {
+		ga.Eddystone = &btgrpc.Eddystone{
+			Frame:              btgrpc.Eddystone_Frame(e.Frame),
+			TxPower:            int32(e.TxPower),
+			Namespace:          e.Namespace,
+			Instance:           e.Instance,
+			Url:                e.URL,
+			BatteryVoltage:     uint32(e.BatteryVoltage),
+			Temperature:        e.Temperature,
+			AdvertisementCount: e.AdvertisementCount,
+			Uptime:             e.Uptime.Milliseconds(),
+			Eid:                e.EID,
+		}
+	}
+	if h := payloads.BTHome; h != nil {
+		ga.Bthome = &btgrpc.BTHome{Encrypted: h.Encrypted, TriggerBased: h.TriggerBased}
+		for _, m := range h.Measurements {
+			ga.Bthome.Measurements = append(ga.Bthome.Measurements, &btgrpc.Measurement{Name: m.Name, Value: m.Value, Unit: m.Unit})
+		}
+	}
+
+	return ga
+}
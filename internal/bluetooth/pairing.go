@@ -0,0 +1,88 @@
+package bluetooth
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net"
+	"os"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	btgrpc "github.com/andree-bjorkgard/remote-bluetooth/internal/bluetooth/grpc"
+	"github.com/andree-bjorkgard/remote-bluetooth/internal/pairing"
+)
+
+func (s *BluetoothServer) GetServerInfo(ctx context.Context, _ *btgrpc.Empty) (*btgrpc.ServerInfo, error) {
+	name, _ := os.Hostname()
+
+	return &btgrpc.ServerInfo{Id: s.registry.ServerID, Name: name}, nil
+}
+
+// PairingNotifier shows the code a client has to confirm to the user of the server
+type PairingNotifier interface {
+	PairingCode(clientName, code string) error
+}
+
+// SetPairingNotifier makes the server show pairing codes through n, they are only logged without one. It must be
+// called before the server is started.
+func (s *BluetoothServer) SetPairingNotifier(n PairingNotifier) {
+	s.pairingNotifier = n
+}
+
+// PairClient starts pairing a new client. The code is only shown on the server, the user has to enter it on the client.
+func (s *BluetoothServer) PairClient(ctx context.Context, request *btgrpc.PairClientRequest) (*btgrpc.PairClientResponse, error) {
+	id, code, err := s.registry.Begin(request.ClientName, peerHost(ctx))
+	if err != nil {
+		log.Printf("Server.PairClient: %s", err)
+		switch {
+		case errors.Is(err, pairing.ErrInvalidName):
+			return nil, newStatus(codes.InvalidArgument, ReasonInvalidName, nil, err.Error())
+		case errors.Is(err, pairing.ErrNameTaken):
+			return nil, newStatus(codes.AlreadyExists, ReasonNameTaken, nil, err.Error())
+		case errors.Is(err, pairing.ErrTooManyPairings):
+			return nil, newStatus(codes.ResourceExhausted, ReasonTooManyPairings, nil, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if s.pairingNotifier == nil {
+		log.Printf("Server.PairClient: %q wants to pair, confirmation code: %s", request.ClientName, code)
+	} else if err := s.pairingNotifier.PairingCode(request.ClientName, code); err != nil {
+		log.Printf("Server.PairClient: could not show the code, %q wants to pair, confirmation code: %s (%s)", request.ClientName, code, err)
+	} else {
+		log.Printf("Server.PairClient: %q wants to pair, the confirmation code is shown in a notification", request.ClientName)
+	}
+
+	return &btgrpc.PairClientResponse{PairingId: id}, nil
+}
+
+// peerHost returns the host the request came from, without the port which changes with every connection
+func peerHost(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+
+	return host
+}
+
+func (s *BluetoothServer) ConfirmPairing(ctx context.Context, request *btgrpc.ConfirmPairingRequest) (*btgrpc.ConfirmPairingResponse, error) {
+	token, err := s.registry.Confirm(request.PairingId, request.Code)
+	if err != nil {
+		log.Printf("Server.ConfirmPairing: %s", err)
+		if errors.Is(err, pairing.ErrWrongCode) || errors.Is(err, pairing.ErrUnknownPairing) {
+			return nil, newStatus(codes.PermissionDenied, ReasonPermissionDenied, nil, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &btgrpc.ConfirmPairingResponse{Token: token}, nil
+}
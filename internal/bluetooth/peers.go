@@ -0,0 +1,192 @@
+package bluetooth
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/andree-bjorkgard/remote-bluetooth/internal/discovery"
+	"github.com/andree-bjorkgard/remote-bluetooth/pkg/config"
+)
+
+const (
+	peerReleaseTimeout = 10 * time.Second
+	// peerQueryTimeout bounds asking a peer whether it holds a device, so a peer that went away does not
+	// hold up connecting
+	peerQueryTimeout = 2 * time.Second
+
+	peerDiscoveryInterval = time.Minute
+	// peerDiscoveryWindow is how long each discovery waits for the peers to answer
+	peerDiscoveryWindow = 5 * time.Second
+	// peerExpiry is how long a peer that stopped answering discoveries is kept
+	peerExpiry = 5 * time.Minute
+)
+
+// peers keeps clients to the other servers on the network, so a device can be released
+// by whichever server holds it before it is connected here.
+type peers struct {
+	mu      sync.Mutex
+	clients map[string]*peerClient
+}
+
+type peerClient struct {
+	client *BluetoothClient
+	// lastSeen is when the peer last answered a discovery
+	lastSeen time.Time
+}
+
+func newPeers() *peers {
+	return &peers{clients: make(map[string]*peerClient)}
+}
+
+// seen records that the peer answered a discovery, it reports false for peers that are not known
+func (p *peers) seen(addr string, now time.Time) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	pr, ok := p.clients[addr]
+	if ok {
+		pr.lastSeen = now
+	}
+
+	return ok
+}
+
+func (p *peers) add(addr string, bc *BluetoothClient, now time.Time) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.clients[addr] = &peerClient{client: bc, lastSeen: now}
+}
+
+// expire drops the peers that were last seen before the time
+func (p *peers) expire(before time.Time) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for addr, pr := range p.clients {
+		if !pr.lastSeen.Before(before) {
+			continue
+		}
+
+		log.Printf("peers.expire: dropping peer %s, last seen %s", addr, pr.lastSeen.Format(time.RFC3339))
+		if err := pr.client.Close(); err != nil {
+			log.Printf("peers.expire: error closing client for %s: %s", addr, err)
+		}
+		delete(p.clients, addr)
+	}
+}
+
+func (p *peers) snapshot() map[string]*BluetoothClient {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	clients := make(map[string]*BluetoothClient, len(p.clients))
+	for addr, pr := range p.clients {
+		clients[addr] = pr.client
+	}
+
+	return clients
+}
+
+// release disconnects the device from the peer currently holding it and waits until the disconnect is confirmed
+func (p *peers) release(ctx context.Context, address string) error {
+	addr, bc := p.holder(ctx, address)
+	if bc == nil {
+		return nil
+	}
+
+	log.Printf("peers.release: asking %s to release %s", addr, address)
+	if err := bc.DisconnectFromDevice(ctx, address); err != nil {
+		return fmt.Errorf("peers.release: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, peerReleaseTimeout)
+	defer cancel()
+
+	return bc.WaitForDisconnect(ctx, address)
+}
+
+// holder asks every peer at once whether it is connected to the device and returns the first one that is,
+// or a nil client if none answers that it is
+func (p *peers) holder(ctx context.Context, address string) (string, *BluetoothClient) {
+	clients := p.snapshot()
+	if len(clients) == 0 {
+		return "", nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, peerQueryTimeout)
+	defer cancel()
+
+	type answer struct {
+		addr   string
+		client *BluetoothClient
+	}
+	answers := make(chan answer, len(clients))
+	for addr, bc := range clients {
+		go func(addr string, bc *BluetoothClient) {
+			connected, err := bc.IsConnected(ctx, address)
+			if err != nil {
+				log.Printf("peers.release: could not query %s: %s", addr, err)
+			}
+			if !connected {
+				bc = nil
+			}
+			answers <- answer{addr: addr, client: bc}
+		}(addr, bc)
+	}
+
+	for range clients {
+		if a := <-answers; a.client != nil {
+			return a.addr, a.client
+		}
+	}
+
+	return "", nil
+}
+
+// FindPeers discovers the other servers on the network every minute, peers that stop answering are dropped.
+// Devices held by a peer are released before they are connected here.
+func (s *BluetoothServer) FindPeers(ds discovery.DiscoveryService, cfg config.Config) {
+	ticker := time.NewTicker(peerDiscoveryInterval)
+	defer ticker.Stop()
+
+	for {
+		s.discoverPeers(ds, cfg)
+		s.peers.expire(time.Now().Add(-peerExpiry))
+
+		<-ticker.C
+	}
+}
+
+func (s *BluetoothServer) discoverPeers(ds discovery.DiscoveryService, cfg config.Config) {
+	ctx, cancel := context.WithTimeout(context.Background(), peerDiscoveryWindow)
+	defer cancel()
+
+	ch, err := ds.DiscoverRemote(ctx)
+	if err != nil {
+		log.Printf("Server.FindPeers: %s", err)
+		return
+	}
+
+	for addr := range ch {
+		if s.peers.seen(addr, time.Now()) {
+			continue
+		}
+
+		bc, err := NewBluetoothClient(addr, cfg)
+		if err != nil {
+			log.Printf("Server.FindPeers: error creating client for %s: %s", addr, err)
+			continue
+		}
+
+		if err := bc.UsePairedToken(context.Background()); err != nil {
+			log.Printf("Server.FindPeers: error looking up token for %s: %s", addr, err)
+		}
+
+		log.Printf("Server.FindPeers: found peer %s", addr)
+		s.peers.add(addr, bc, time.Now())
+	}
+}
@@ -0,0 +1,140 @@
+package bluetooth
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const baseUUID = "-0000-1000-8000-00805f9b34fb"
+
+var (
+	ErrUnknownProfile      = errors.New("unknown profile")
+	ErrProfileNotSupported = errors.New("profile not supported by device")
+)
+
+var uuidPattern = regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$`)
+
+// Short names of the profiles that are connected one at a time. Each name covers both roles of a
+// profile, the role the device advertises is used.
+var profileAliases = map[string][]string{
+	"a2dp":  {uuid16(0x110b), uuid16(0x110a)},
+	"avrcp": {uuid16(0x110e), uuid16(0x110c)},
+	"hfp":   {uuid16(0x111e), uuid16(0x111f)},
+	"hsp":   {uuid16(0x1108), uuid16(0x1112)},
+	"hid":   {uuid16(0x1124), uuid16(0x1812)},
+	"pan":   {uuid16(0x1116), uuid16(0x1115), uuid16(0x1117)},
+	"spp":   {uuid16(0x1101)},
+}
+
+// Names of the assigned service class and GATT service UUIDs devices commonly advertise
+var profileNames = map[string]string{
+	uuid16(0x1101): "Serial Port",
+	uuid16(0x1103): "Dial-up Networking",
+	uuid16(0x1105): "OBEX Object Push",
+	uuid16(0x1106): "OBEX File Transfer",
+	uuid16(0x1108): "Headset",
+	uuid16(0x110a): "Audio Source",
+	uuid16(0x110b): "Audio Sink",
+	uuid16(0x110c): "A/V Remote Control Target",
+	uuid16(0x110d): "Advanced Audio Distribution",
+	uuid16(0x110e): "A/V Remote Control",
+	uuid16(0x110f): "A/V Remote Control Controller",
+	uuid16(0x1112): "Headset Audio Gateway",
+	uuid16(0x1115): "PAN User",
+	uuid16(0x1116): "Network Access Point",
+	uuid16(0x1117): "Group Ad-hoc Network",
+	uuid16(0x111e): "Handsfree",
+	uuid16(0x111f): "Handsfree Audio Gateway",
+	uuid16(0x1124): "Human Interface Device",
+	uuid16(0x112d): "SIM Access",
+	uuid16(0x112f): "Phonebook Access Server",
+	uuid16(0x1132): "Message Access Server",
+	uuid16(0x1133): "Message Notification Server",
+	uuid16(0x1200): "PnP Information",
+	uuid16(0x1203): "Generic Audio",
+	uuid16(0x1800): "Generic Access",
+	uuid16(0x1801): "Generic Attribute",
+	uuid16(0x1805): "Current Time",
+	uuid16(0x180a): "Device Information",
+	uuid16(0x180d): "Heart Rate",
+	uuid16(0x180f): "Battery Service",
+	uuid16(0x1812): "HID over GATT",
+	uuid16(0x1816): "Cycling Speed and Cadence",
+	uuid16(0x1818): "Cycling Power",
+	uuid16(0x1844): "Volume Control",
+	uuid16(0x1845): "Volume Offset Control",
+	uuid16(0x1848): "Media Control",
+	uuid16(0x1849): "Generic Media Control",
+	uuid16(0x184e): "Audio Stream Control",
+	uuid16(0x184f): "Broadcast Audio Scan",
+	uuid16(0x1850): "Published Audio Capabilities",
+	uuid16(0x1853): "Common Audio",
+	uuid16(0x1854): "Hearing Access",
+}
+
+func uuid16(id uint16) string {
+	return fmt.Sprintf("0000%04x%s", id, baseUUID)
+}
+
+// profileName returns the name of a service UUID, or the UUID itself if it is not assigned
+func profileName(uuid string) string {
+	if name, ok := profileNames[strings.ToLower(uuid)]; ok {
+		return name
+	}
+
+	return uuid
+}
+
+// resolveProfile turns a profile given as a UUID, a 16 or 32 bit UUID like "110b", a short name like
+// "a2dp" or a name like "Audio Sink" into the full UUID of a profile the device advertises
+func resolveProfile(profile string, advertised []string) (string, error) {
+	p := strings.ToLower(strings.TrimSpace(profile))
+	supported := make([]string, 0, len(advertised))
+	for _, uuid := range advertised {
+		supported = append(supported, strings.ToLower(uuid))
+	}
+
+	candidates, ok := profileAliases[p]
+	if !ok {
+		uuid, err := profileUUID(p)
+		if err != nil {
+			return "", fmt.Errorf("%w: %q", err, profile)
+		}
+		candidates = []string{uuid}
+	}
+
+	for _, uuid := range candidates {
+		if contains(supported, uuid) {
+			return uuid, nil
+		}
+	}
+
+	return "", fmt.Errorf("%w: %s", ErrProfileNotSupported, profile)
+}
+
+func profileUUID(p string) (string, error) {
+	for uuid, name := range profileNames {
+		if strings.ToLower(name) == p {
+			return uuid, nil
+		}
+	}
+
+	if uuid, ok := parseUUID(p); ok {
+		return uuid, nil
+	}
+
+	return "", ErrUnknownProfile
+}
+
+// parseUUID turns a lowercase 16 or 32 bit UUID like "110b" or "0x2a19", or a full UUID, into a full UUID
+func parseUUID(p string) (string, bool) {
+	short := strings.TrimPrefix(p, "0x")
+	if id, err := strconv.ParseUint(short, 16, 32); err == nil && (len(short) == 4 || len(short) == 8) {
+		return fmt.Sprintf("%08x%s", id, baseUUID), true
+	}
+
+	return p, uuidPattern.MatchString(p)
+}
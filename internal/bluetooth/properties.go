@@ -0,0 +1,97 @@
+package bluetooth
+
+import (
+	"log"
+	"sync"
+
+	"github.com/godbus/dbus/v5"
+	"github.com/muka/go-bluetooth/bluez"
+)
+
+// watchPropertiesChanged subscribes to the PropertiesChanged signals of one interface of an object and sends
+// the changed properties of every signal. Unlike bluez.WatchProperties it does not need the generated type of
+// the interface. Calling stop ends the subscription and closes the channel.
+func watchPropertiesChanged(path dbus.ObjectPath, iface string) (<-chan map[string]dbus.Variant, func(), error) {
+	conn, err := bluez.GetConnection(bluez.SystemBus)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	match := []dbus.MatchOption{
+		dbus.WithMatchObjectPath(path),
+		dbus.WithMatchInterface(bluez.PropertiesInterface),
+		dbus.WithMatchMember("PropertiesChanged"),
+		dbus.WithMatchOption("arg0", iface),
+	}
+	if err := conn.AddMatchSignal(match...); err != nil {
+		return nil, nil, err
+	}
+
+	signals := make(chan *dbus.Signal, 10)
+	conn.Signal(signals)
+
+	changed := make(chan map[string]dbus.Variant, 10)
+	done := make(chan struct{})
+	stopped := make(chan struct{})
+
+	go func() {
+		defer close(stopped)
+		defer close(changed)
+		for {
+			var sig *dbus.Signal
+			select {
+			case <-done:
+				return
+			case sig = <-signals:
+			}
+			// The connection is shared, so the signals of every match arrive here
+			if sig == nil || sig.Path != path || sig.Name != bluez.PropertiesInterface+".PropertiesChanged" || len(sig.Body) < 2 {
+				continue
+			}
+			if name, _ := sig.Body[0].(string); name != iface {
+				continue
+			}
+			props, _ := sig.Body[1].(map[string]dbus.Variant)
+
+			select {
+			case changed <- props:
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	var once sync.Once
+	stop := func() {
+		once.Do(func() {
+			close(done)
+			<-stopped
+			conn.RemoveSignal(signals)
+			if err := conn.RemoveMatchSignal(match...); err != nil {
+				log.Println("Error unwatching properties:", err)
+			}
+		})
+	}
+
+	return changed, stop, nil
+}
+
+// watchProperties signals on the returned channel whenever a property of one interface of an object changes
+func watchProperties(path dbus.ObjectPath, iface string) (<-chan struct{}, func(), error) {
+	changed, stop, err := watchPropertiesChanged(path, iface)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	changes := make(chan struct{}, 1)
+	go func() {
+		for range changed {
+			select {
+			case changes <- struct{}{}:
+			default:
+			}
+		}
+	}()
+
+	return changes, stop, nil
+}
@@ -0,0 +1,38 @@
+package bluetooth
+
+import (
+	"github.com/muka/go-bluetooth/bluez/profile/battery"
+)
+
+// BatteryProvider reads battery levels from one source. The server asks its providers in order,
+// the first one that knows the level of a device wins.
+type BatteryProvider interface {
+	// BatteryLevel returns the percentage and where it comes from, ok is false if the level is unknown
+	BatteryLevel(dev Device) (percentage byte, source string, ok bool)
+}
+
+type bluezBatteryProvider struct{}
+
+// NewBlueZBatteryProvider reads org.bluez.Battery1. BlueZ exposes it for the GATT Battery Service and,
+// with experimental features enabled, for levels other programs such as the audio server register
+// through BatteryProvider1. Those report where the level comes from, e.g. "HFP", in the Source property.
+// The levels headsets send in the vendor AT commands of the hands-free profile, +IPHONEACCEV and +XEVENT,
+// only reach the server this way, since the audio server terminates the HFP connection and parses them.
+func NewBlueZBatteryProvider() BatteryProvider {
+	return bluezBatteryProvider{}
+}
+
+func (bluezBatteryProvider) BatteryLevel(dev Device) (byte, string, bool) {
+	p, err := dev.GetBatteryPercentage()
+	if err != nil {
+		return 0, "", false
+	}
+
+	// Source is experimental and missing on older BlueZ versions
+	source, err := dev.GetBatterySource()
+	if err != nil || source == "" {
+		source = battery.Battery1Interface
+	}
+
+	return p, source, true
+}
@@ -0,0 +1,40 @@
+package bluetooth_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/andree-bjorkgard/remote-bluetooth/internal/bluetooth"
+	"github.com/andree-bjorkgard/remote-bluetooth/internal/bluetooth/fake"
+	btgrpc "github.com/andree-bjorkgard/remote-bluetooth/internal/bluetooth/grpc"
+)
+
+func TestBatteryProviders(t *testing.T) {
+	headphones, _ := newTestDevices()
+	provider := fake.NewBatteryProvider()
+	lis := testServer(t, fake.NewAdapters(fake.NewAdapter(headphones)), "", func(s *bluetooth.BluetoothServer) {
+		s.SetBatteryProviders(provider, bluetooth.NewBlueZBatteryProvider())
+	})
+	bc := testClient(t, lis, testSecret)
+
+	battery := func() *btgrpc.Battery {
+		t.Helper()
+
+		d, err := bc.GetDevice(context.Background(), "AA:AA:AA:AA:AA:01")
+		if err != nil {
+			t.Fatal(err)
+		}
+		return d.Battery
+	}
+
+	// The first provider that knows the level wins
+	provider.Set("AA:AA:AA:AA:AA:01", 40, "HFP")
+	if b := battery(); b.GetPercentage() != 40 || b.GetSource() != "HFP" {
+		t.Fatalf("battery = %v, want 40%% from HFP", b)
+	}
+
+	provider.Clear("AA:AA:AA:AA:AA:01")
+	if b := battery(); b.GetPercentage() != 80 {
+		t.Fatalf("battery = %v, want 80%% from BlueZ", b)
+	}
+}
@@ -0,0 +1,205 @@
+package bluetooth
+
+import (
+	"log"
+	"sync"
+
+	"github.com/muka/go-bluetooth/bluez/profile/adapter"
+	"github.com/muka/go-bluetooth/bluez/profile/device"
+)
+
+// Discover starts BlueZ discovery, shared with the observations of the adapter. New devices are reported when BlueZ adds them, devices BlueZ already
+// knows are reported whenever their RSSI changes, which only happens while they are seen by the scan.
+// Stopping never waits for the receiver of found.
+func (a *bluezAdapter) Discover() (<-chan Device, func(), error) {
+	added, cancelAdded, err := a.adapter.OnDeviceDiscovered()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	release, err := discoveryOf(a.adapter).start(nil)
+	if err != nil {
+		cancelAdded()
+		return nil, nil, err
+	}
+
+	found := make(chan Device, 10)
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+
+	send := func(d Device) {
+		select {
+		case found <- d:
+		case <-done:
+		}
+	}
+
+	watchRSSI := func(d *device.Device1) {
+		ch, err := d.WatchProperties()
+		if err != nil {
+			log.Println("Error watching device properties:", err)
+			return
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() {
+				if err := d.UnwatchProperties(ch); err != nil {
+					log.Println("Error unwatching device properties:", err)
+				}
+			}()
+
+			for {
+				select {
+				case <-done:
+					return
+				case p := <-ch:
+					if p == nil {
+						return
+					}
+					if p.Interface == device.Device1Interface && p.Name == "RSSI" {
+						send(&bluezDevice{d})
+					}
+				}
+			}
+		}()
+	}
+
+	known, err := a.adapter.GetDevices()
+	if err != nil {
+		log.Println("Error getting known devices:", err)
+	}
+	for _, d := range known {
+		watchRSSI(d)
+	}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for {
+			var ev *adapter.DeviceDiscovered
+			select {
+			case <-done:
+				return
+			case ev = <-added:
+			}
+			if ev == nil {
+				return
+			}
+			if ev.Type != adapter.DeviceAdded {
+				continue
+			}
+
+			d, err := device.NewDevice1(ev.Path)
+			if err != nil {
+				log.Println("Error loading discovered device:", err)
+				continue
+			}
+			send(&bluezDevice{d})
+			watchRSSI(d)
+		}
+	}()
+
+	var once sync.Once
+	stop := func() {
+		once.Do(func() {
+			release()
+			close(done)
+			cancelAdded()
+			wg.Wait()
+			close(found)
+		})
+	}
+
+	return found, stop, nil
+}
+
+// scanner shares a single adapter discovery between all clients that are scanning
+type scanner struct {
+	adapter Adapter
+
+	mu       sync.Mutex
+	sessions map[chan Device]struct{}
+	stop     func()
+}
+
+func newScanner(adapter Adapter) *scanner {
+	return &scanner{adapter: adapter, sessions: make(map[chan Device]struct{})}
+}
+
+// subscribe starts discovery if nobody is scanning yet. The returned channel is closed once
+// unsubscribe or stopAll is called.
+func (s *scanner) subscribe() (found <-chan Device, unsubscribe func(), err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.stop == nil {
+		ch, stop, err := s.adapter.Discover()
+		if err != nil {
+			return nil, nil, err
+		}
+		s.stop = stop
+		go s.fanOut(ch)
+	}
+
+	session := make(chan Device, 20)
+	s.sessions[session] = struct{}{}
+
+	return session, func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		if _, ok := s.sessions[session]; !ok {
+			return
+		}
+		delete(s.sessions, session)
+		close(session)
+		if len(s.sessions) == 0 {
+			s.stopDiscovery()
+		}
+	}, nil
+}
+
+// stopAll stops discovery and ends every session
+func (s *scanner) stopAll() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for session := range s.sessions {
+		delete(s.sessions, session)
+		close(session)
+	}
+	s.stopDiscovery()
+}
+
+func (s *scanner) stopDiscovery() {
+	if s.stop != nil {
+		s.stop()
+		s.stop = nil
+	}
+}
+
+func (s *scanner) fanOut(found <-chan Device) {
+	for d := range found {
+		s.mu.Lock()
+		for session := range s.sessions {
+			select {
+			case session <- d:
+			default:
+				// A slow client misses an update rather than stalling everybody else
+			}
+		}
+		s.mu.Unlock()
+	}
+}
+
+// subscribeAll subscribes to the discovery of every adapter. The returned channel is closed once every
+// subscription has ended, which unsubscribe or stopAll on all of the adapters does.
+func subscribeAll(adapters []*managedAdapter) (found <-chan Device, unsubscribe func(), err error) {
+	return mergeAdapters(adapters, func(a *managedAdapter) (<-chan Device, func(), error) {
+		return a.scanner.subscribe()
+	}, func(_ *managedAdapter, d Device) Device {
+		return d
+	})
+}
@@ -5,15 +5,17 @@ import (
 	"fmt"
 	"log"
 	"net"
+	"strings"
+	"sync"
 
-	"github.com/muka/go-bluetooth/api"
-	"github.com/muka/go-bluetooth/bluez/profile/adapter"
-	"github.com/muka/go-bluetooth/bluez/profile/battery"
-	"github.com/muka/go-bluetooth/bluez/profile/device"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/credentials"
 
+	"github.com/andree-bjorkgard/remote-bluetooth/internal/acl"
+	"github.com/andree-bjorkgard/remote-bluetooth/internal/battery"
 	btgrpc "github.com/andree-bjorkgard/remote-bluetooth/internal/bluetooth/grpc"
+	"github.com/andree-bjorkgard/remote-bluetooth/internal/certs"
+	"github.com/andree-bjorkgard/remote-bluetooth/internal/pairing"
 	"github.com/andree-bjorkgard/remote-bluetooth/pkg/config"
 )
 
@@ -22,73 +24,160 @@ const BATTERY_UUID = "0000180f-0000-1000-8000-00805f9b34fb"
 type BluetoothServer struct {
 	btgrpc.UnimplementedBluetoothServer
 
-	port    int
-	adapter *adapter.Adapter1
+	port       int
+	source     AdapterSource
+	adapters   *adapterSet
+	peers      *peers
+	agent      *remoteAgent
+	batteries  *battery.History
+	thresholds *battery.Thresholds
+	alerts     *broadcaster[batteryAlert]
+	registry   *pairing.Registry
+	acl        *acl.ACL
+
+	batteryProviders []BatteryProvider
+	pairingNotifier  PairingNotifier
+
+	agentMu         sync.Mutex
+	unregisterAgent func()
 }
 
 var _ btgrpc.BluetoothServer = (*BluetoothServer)(nil)
 
-func NewBluetoothServer(port int, adapterID string) *BluetoothServer {
-	var adapter *adapter.Adapter1
-	var err error
+// NewBluetoothServer manages every BlueZ adapter, requests that name no adapter use the one with
+// defaultAdapterID or the first one if it is empty or missing.
+func NewBluetoothServer(port int, defaultAdapterID string) *BluetoothServer {
+	return NewBluetoothServerWithAdapterSource(port, NewBlueZAdapterSource(), defaultAdapterID)
+}
 
-	if adapterID == "" {
-		adapter, err = api.GetDefaultAdapter()
+// NewBluetoothServerWithAdapter creates a server backed by the given adapter instead of BlueZ.
+func NewBluetoothServerWithAdapter(port int, adapter Adapter) *BluetoothServer {
+	return NewBluetoothServerWithAdapterSource(port, staticAdapterSource{adapter}, adapter.GetID())
+}
 
-	} else {
-		adapter, err = api.GetAdapter(adapterID)
+// NewBluetoothServerWithAdapterSource creates a server managing the adapters of source.
+func NewBluetoothServerWithAdapterSource(port int, source AdapterSource, defaultAdapterID string) *BluetoothServer {
+	return &BluetoothServer{
+		port:             port,
+		source:           source,
+		adapters:         newAdapterSet(defaultAdapterID),
+		peers:            newPeers(),
+		agent:            newRemoteAgent(),
+		alerts:           newBroadcaster[batteryAlert](),
+		batteryProviders: []BatteryProvider{NewBlueZBatteryProvider()},
 	}
+}
 
+func (s *BluetoothServer) Start() error {
+	listener, err := net.Listen("tcp", fmt.Sprintf("0.0.0.0:%d", s.port))
 	if err != nil {
-		panic(err)
+		return fmt.Errorf("Server.Start: %w", err)
 	}
 
-	return &BluetoothServer{port: port, adapter: adapter}
+	log.Printf("Server.Start: Starting server on: %s", listener.Addr())
+
+	return s.Serve(listener)
 }
 
-func (s *BluetoothServer) Start() error {
+// Serve serves the gRPC API on an already opened listener.
+func (s *BluetoothServer) Serve(listener net.Listener) error {
+	cfg := config.NewConfig()
+
+	registry, err := pairing.LoadRegistry(cfg.ClientsFile)
+	if err != nil {
+		return fmt.Errorf("Server.Serve: %w", err)
+	}
+	s.registry = registry
+
+	rules, err := acl.Load(cfg.ACLFile)
+	if err != nil {
+		return fmt.Errorf("Server.Serve: %w", err)
+	}
+	s.acl = rules
+
 	var opts []grpc.ServerOption = []grpc.ServerOption{
-		grpc.UnaryInterceptor(unaryServerInterceptor(config.NewConfig())),
+		grpc.UnaryInterceptor(unaryServerInterceptor(cfg, registry, rules)),
+		grpc.StreamInterceptor(streamServerInterceptor(cfg, registry, rules)),
 	}
-	grpcServer := grpc.NewServer(opts...)
-	listener, err := net.Listen("tcp", fmt.Sprintf("0.0.0.0:%d", s.port))
 
+	tlsCfg, err := certs.ServerConfig(cfg.TLSCertFile, cfg.TLSKeyFile, cfg.TLSCAFile)
 	if err != nil {
-		return fmt.Errorf("Server.Start: %w", err)
+		return fmt.Errorf("Server.Serve: %w", err)
+	}
+	if tlsCfg != nil {
+		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsCfg)))
+	} else {
+		log.Println("Server.Serve: TLS is not configured, serving plaintext")
 	}
 
-	log.Printf("Server.Start: Starting server on: %s", listener.Addr())
+	defer s.dropAgent()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Watch before binding so no adapter plugged in meanwhile is missed. Without the watches the
+	// adapters found now are served until the server restarts.
+	running, stopService, err := s.source.WatchService()
+	if err != nil {
+		log.Println("Server.Serve: not watching the Bluetooth service:", err)
+	} else {
+		defer stopService()
+	}
+	added, removed, stopWatching, err := s.source.WatchAdapters()
+	if err != nil {
+		log.Println("Server.Serve: not watching adapters:", err)
+	} else {
+		defer stopWatching()
+	}
+
+	s.bind()
+	go s.watchAdapters(ctx, running, added, removed)
+
+	s.batteries = battery.NewHistory(cfg.BatteryHistory)
+	s.thresholds = battery.NewThresholds(cfg.BatteryThresholds)
+	go s.watchBatteries(ctx, cfg.BatterySampleInterval)
+
+	grpcServer := grpc.NewServer(opts...)
 	btgrpc.RegisterBluetoothServer(grpcServer, s)
 
 	return grpcServer.Serve(listener)
 }
 
 func (s *BluetoothServer) GetTrustedDevices(ctx context.Context, _ *btgrpc.Empty) (*btgrpc.Devices, error) {
-	var devs *btgrpc.Devices
+	trusted := true
+	return s.listDevices(ctx, "GetTrustedDevices", &btgrpc.ListDevicesRequest{Filter: &btgrpc.DeviceFilter{Trusted: &trusted}})
+}
 
-	rawDevs, err := s.adapter.GetDevices()
+// GetDevice looks up a single device by its address
+func (s *BluetoothServer) GetDevice(ctx context.Context, request *btgrpc.DeviceRequest) (*btgrpc.Device, error) {
+	dev, err := s.allowedDevice(ctx, "GetDevice", request.AdapterId, request.Address)
 	if err != nil {
-		return devs, err
-	}
-	devs = &btgrpc.Devices{}
-	for _, rd := range rawDevs {
-		if rd != nil && rd.Properties.Trusted {
-			dev := deviceToGrpcDevice(rd)
-			devs.Devices = append(devs.Devices, dev)
-		}
+		return nil, err
 	}
-	return devs, nil
+
+	return s.grpcDevice(dev), nil
 }
 
 func (s *BluetoothServer) ConnectToDevice(ctx context.Context, request *btgrpc.ConnectRequest) (*btgrpc.Response, error) {
 	resp := &btgrpc.Response{Success: false}
-	dev, err := s.adapter.GetDeviceByAddress(request.Address)
+	dev, adapter, err := s.device(request.AdapterId, request.Address)
 	if err != nil {
+		return resp, deviceError(err, request.Address)
+	}
+	if !s.allowed(ctx, "ConnectToDevice", dev) {
+		return resp, permissionDenied(ctx, "ConnectToDevice", request.Address)
+	}
+	if err := s.ensurePowered(ctx, adapter, request.PowerOn); err != nil {
 		return resp, err
 	}
-	err = dev.Connect()
+
+	// The connect usually fails while the device is still held by another host
+	if err := s.peers.release(ctx, request.Address); err != nil {
+		log.Println("Error releasing device from peer:", err)
+	}
+
+	err = dev.Connect(ctx)
 	if err != nil {
-		return resp, err
+		return resp, deviceError(err, request.Address)
 	}
 
 	resp.Success = true
@@ -97,72 +186,234 @@ func (s *BluetoothServer) ConnectToDevice(ctx context.Context, request *btgrpc.C
 
 func (s *BluetoothServer) DisconnectFromDevice(ctx context.Context, request *btgrpc.DisconnectRequest) (*btgrpc.Response, error) {
 	resp := &btgrpc.Response{Success: false}
-	dev, err := s.adapter.GetDeviceByAddress(request.Address)
+	dev, _, err := s.device(request.AdapterId, request.Address)
 	if err != nil {
-		return resp, err
+		return resp, deviceError(err, request.Address)
 	}
-	err = dev.Disconnect()
+	if !s.allowed(ctx, "DisconnectFromDevice", dev) {
+		return resp, permissionDenied(ctx, "DisconnectFromDevice", request.Address)
+	}
+	err = dev.Disconnect(ctx)
 	if err != nil {
-		return resp, err
+		return resp, deviceError(err, request.Address)
 	}
 
 	resp.Success = true
-	return resp, err
-}
-
-func getBatteryStatus(dev *device.Device1) string {
-	if dev.Properties.Connected {
-		for _, uuid := range dev.Properties.UUIDs {
-			if uuid == BATTERY_UUID {
-				b, err := battery.NewBattery1(dev.Path())
-				if err != nil {
-					log.Println("Error getting battery service:", err)
-					return ""
-				}
-				p, err := b.GetPercentage()
-				if err != nil {
-					log.Println("Error getting battery percentage:", err)
-					return ""
-				}
-
-				return fmt.Sprintf("%d", p)
+	return resp, nil
+}
+
+// ConnectProfile connects a single profile, e.g. A2DP for music without the microphone of HFP
+func (s *BluetoothServer) ConnectProfile(ctx context.Context, request *btgrpc.ProfileRequest) (*btgrpc.Response, error) {
+	return s.onDevice(ctx, "ConnectProfile", request.AdapterId, request.Address, func(dev Device, adapter Adapter) error {
+		uuid, err := deviceProfile(dev, request.Profile)
+		if err != nil {
+			return err
+		}
+		if err := s.ensurePowered(ctx, adapter, false); err != nil {
+			return err
+		}
+		if err := s.peers.release(ctx, request.Address); err != nil {
+			log.Println("Error releasing device from peer:", err)
+		}
+
+		return dev.ConnectProfile(ctx, uuid)
+	})
+}
+
+// DisconnectProfile disconnects a single profile and keeps the others connected
+func (s *BluetoothServer) DisconnectProfile(ctx context.Context, request *btgrpc.ProfileRequest) (*btgrpc.Response, error) {
+	return s.onDevice(ctx, "DisconnectProfile", request.AdapterId, request.Address, func(dev Device, _ Adapter) error {
+		uuid, err := deviceProfile(dev, request.Profile)
+		if err != nil {
+			return err
+		}
+
+		return dev.DisconnectProfile(ctx, uuid)
+	})
+}
+
+// deviceProfile resolves the profile against the profiles the device advertises
+func deviceProfile(dev Device, profile string) (string, error) {
+	uuids, err := dev.GetUUIDs()
+	if err != nil {
+		return "", err
+	}
+
+	return resolveProfile(profile, uuids)
+}
+
+func (s *BluetoothServer) WatchDevices(_ *btgrpc.Empty, stream btgrpc.Bluetooth_WatchDevicesServer) error {
+	ctx := stream.Context()
+	if _, err := s.adapters.list(""); err != nil {
+		return deviceError(err, "")
+	}
+
+	updates := s.followDevices(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case d := <-updates:
+			// Watching exposes the same devices as GetTrustedDevices, so it follows the same rules. They are
+			// checked on every change, since a device becomes trusted by being paired.
+			if trusted, _ := d.GetTrusted(); !trusted || !s.allowed(ctx, "GetTrustedDevices", d) {
+				continue
+			}
+
+			if err := stream.Send(s.grpcDevice(d)); err != nil {
+				return err
 			}
 		}
 	}
+}
+
+func forwardChanges(ctx context.Context, dev Device, changes <-chan struct{}, updates chan<- Device) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-changes:
+			select {
+			case updates <- dev:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}
+}
+
+// batteryLevel returns the battery percentage of a connected device and its source, from the first
+// provider that knows it
+func (s *BluetoothServer) batteryLevel(dev Device) (byte, string, bool) {
+	if connected, _ := dev.GetConnected(); !connected {
+		return 0, "", false
+	}
+
+	for _, p := range s.batteryProviders {
+		if percentage, source, ok := p.BatteryLevel(dev); ok {
+			return percentage, source, true
+		}
+	}
+
+	return 0, "", false
+}
+
+// SetBatteryProviders replaces the providers battery levels are read from, by default only
+// org.bluez.Battery1 is read. It must be called before the server is started.
+func (s *BluetoothServer) SetBatteryProviders(providers ...BatteryProvider) {
+	s.batteryProviders = providers
+}
+
+// battery returns the battery level of a device, or nil if no provider knows it
+func (s *BluetoothServer) battery(dev Device) *btgrpc.Battery {
+	p, source, ok := s.batteryLevel(dev)
+	if !ok {
+		return nil
+	}
+
+	return &btgrpc.Battery{Percentage: uint32(p), Source: source}
+}
+
+// hasBattery reports whether the battery level is known, or the device has the GATT Battery Service
+func hasBattery(dev Device, battery *btgrpc.Battery) bool {
+	if battery != nil {
+		return true
+	}
+
+	uuids, _ := dev.GetUUIDs()
+	for _, uuid := range uuids {
+		if uuid == BATTERY_UUID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// grpcDevice converts a device including its battery level
+func (s *BluetoothServer) grpcDevice(d Device) *btgrpc.Device {
+	return grpcDeviceWithBattery(d, s.battery(d))
+}
+
+// grpcDeviceWithBattery converts a device with the battery level already read, which is nil if unknown
+func grpcDeviceWithBattery(d Device, battery *btgrpc.Battery) *btgrpc.Device {
+	dev := deviceToGrpcDevice(d)
+	if battery != nil {
+		dev.Battery = battery
+		dev.BatteryStatus = fmt.Sprintf("%d", battery.Percentage)
+	}
 
-	return ""
+	return dev
 }
 
-func deviceToGrpcDevice(d *device.Device1) *btgrpc.Device {
+// deviceToGrpcDevice converts the properties of a device, without reading its battery
+func deviceToGrpcDevice(d Device) *btgrpc.Device {
 	addr, _ := d.GetAddress()
 	name, _ := d.GetName()
+	alias, _ := d.GetAlias()
 	trusted, _ := d.GetTrusted()
 	paired, _ := d.GetPaired()
 	connected, _ := d.GetConnected()
+	blocked, _ := d.GetBlocked()
+	legacyPairing, _ := d.GetLegacyPairing()
 	icon, _ := d.GetIcon()
+	class, _ := d.GetClass()
+	appearance, _ := d.GetAppearance()
+	uuids, _ := d.GetUUIDs()
+	modalias, _ := d.GetModalias()
+	adapterID, _ := d.GetAdapterID()
 
-	return &btgrpc.Device{
+	dev := &btgrpc.Device{
 		Address:       addr,
 		Name:          name,
+		Alias:         alias,
 		Trusted:       trusted,
 		Paired:        paired,
 		Connected:     connected,
+		Blocked:       blocked,
+		LegacyPairing: legacyPairing,
 		Icon:          icon,
-		BatteryStatus: getBatteryStatus(d),
+		Class:         class,
+		Appearance:    uint32(appearance),
+		Uuids:         uuids,
+		Modalias:      parseModalias(modalias),
+		AdapterId:     adapterID,
 	}
-}
 
-func unaryServerInterceptor(cfg config.Config) grpc.UnaryServerInterceptor {
-	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-		md, ok := metadata.FromIncomingContext(ctx)
-		if !ok {
-			md = metadata.New(nil)
-		}
-		secret := md.Get("Authorization")
-		if len(secret) != 1 || secret[0] != cfg.AuthenticationSecret {
-			return nil, fmt.Errorf("UnaryServerInterceptor: invalid secret")
+	// BlueZ only has these properties while the device is in range
+	if rssi, err := d.GetRSSI(); err == nil {
+		v := int32(rssi)
+		dev.Rssi = &v
+	}
+	if txPower, err := d.GetTxPower(); err == nil {
+		v := int32(txPower)
+		dev.TxPower = &v
+	}
+
+	for _, uuid := range uuids {
+		dev.Profiles = append(dev.Profiles, &btgrpc.Profile{Uuid: uuid, Name: profileName(uuid)})
+	}
+	if connected {
+		profiles, _ := d.GetConnectedProfiles()
+		for _, uuid := range profiles {
+			dev.ConnectedProfiles = append(dev.ConnectedProfiles, &btgrpc.Profile{Uuid: uuid, Name: profileName(uuid)})
 		}
+	}
+
+	return dev
+}
 
-		return handler(ctx, req)
+// parseModalias parses a modalias like usb:v046DpB342d0001, nil if it is missing or malformed
+func parseModalias(modalias string) *btgrpc.Modalias {
+	source, ids, ok := strings.Cut(modalias, ":")
+	if !ok {
+		return nil
 	}
+
+	var vendor, product, version uint32
+	if _, err := fmt.Sscanf(strings.ToLower(ids), "v%4xp%4xd%4x", &vendor, &product, &version); err != nil {
+		return nil
+	}
+
+	return &btgrpc.Modalias{Source: source, Vendor: vendor, Product: product, Version: version}
 }
@@ -0,0 +1,442 @@
+package bluetooth_test
+
+import (
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/godbus/dbus/v5"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/andree-bjorkgard/remote-bluetooth/internal/bluetooth"
+	"github.com/andree-bjorkgard/remote-bluetooth/internal/bluetooth/fake"
+	btgrpc "github.com/andree-bjorkgard/remote-bluetooth/internal/bluetooth/grpc"
+	"github.com/andree-bjorkgard/remote-bluetooth/pkg/config"
+)
+
+const testSecret = "s3cret"
+
+// testServer serves the adapters of source on an in-memory listener. The ACL is not set if aclJSON is empty,
+// setup is called before the server is started.
+func testServer(t *testing.T, source bluetooth.AdapterSource, aclJSON string, setup ...func(*bluetooth.BluetoothServer)) *bufconn.Listener {
+	t.Helper()
+
+	dir := t.TempDir()
+	t.Setenv("REMOTE_BLUETOOTH_SECRET", testSecret)
+	t.Setenv("REMOTE_BLUETOOTH_CLIENTS_FILE", filepath.Join(dir, "clients.json"))
+	t.Setenv("REMOTE_BLUETOOTH_TOKENS_FILE", filepath.Join(dir, "tokens.json"))
+	t.Setenv("REMOTE_BLUETOOTH_BATTERY_SAMPLE_INTERVAL", "0")
+	t.Setenv("REMOTE_BLUETOOTH_ACL_FILE", "")
+	if aclJSON != "" {
+		aclFile := filepath.Join(dir, "acl.json")
+		if err := os.WriteFile(aclFile, []byte(aclJSON), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		t.Setenv("REMOTE_BLUETOOTH_ACL_FILE", aclFile)
+	}
+
+	lis := bufconn.Listen(1 << 20)
+	srv := bluetooth.NewBluetoothServerWithAdapterSource(0, source, "")
+	for _, fn := range setup {
+		fn(srv)
+	}
+	go srv.Serve(lis)
+	t.Cleanup(func() { lis.Close() })
+
+	return lis
+}
+
+// testClient dials the server with the secret
+func testClient(t *testing.T, lis *bufconn.Listener, secret string) *bluetooth.BluetoothClient {
+	t.Helper()
+
+	cfg := config.NewConfig()
+	cfg.AuthenticationSecret = secret
+	dial := grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+		return lis.DialContext(ctx)
+	})
+	bc, err := bluetooth.NewBluetoothClient("passthrough:///bufconn", cfg, dial)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { bc.Close() })
+
+	return bc
+}
+
+func newTestDevices() (*fake.Device, *fake.Device) {
+	battery := byte(80)
+	headphones := fake.NewDevice(fake.Properties{Address: "AA:AA:AA:AA:AA:01", Name: "Headphones", Trusted: true, Paired: true, Connected: true, Battery: &battery})
+	speaker := fake.NewDevice(fake.Properties{Address: "AA:AA:AA:AA:AA:02", Name: "Speaker", Trusted: true, Paired: true})
+
+	return headphones, speaker
+}
+
+// assertStatus fails unless err is a status with the code and an ErrorInfo with the reason
+func assertStatus(t *testing.T, err error, code codes.Code, reason string) *errdetails.ErrorInfo {
+	t.Helper()
+
+	st, ok := status.FromError(err)
+	if !ok {
+		t.Fatalf("error %v is not a status", err)
+	}
+	if st.Code() != code {
+		t.Fatalf("code = %s, want %s (%v)", st.Code(), code, err)
+	}
+	for _, d := range st.Details() {
+		if info, ok := d.(*errdetails.ErrorInfo); ok {
+			if info.Reason != reason {
+				t.Fatalf("reason = %s, want %s", info.Reason, reason)
+			}
+			return info
+		}
+	}
+	t.Fatalf("error %v has no ErrorInfo", err)
+
+	return nil
+}
+
+func TestListDevices(t *testing.T) {
+	headphones, speaker := newTestDevices()
+	other := fake.NewDevice(fake.Properties{Address: "AA:AA:AA:AA:AA:03", Name: "Mouse"})
+	lis := testServer(t, fake.NewAdapters(fake.NewAdapter(speaker, headphones, other)), "")
+	bc := testClient(t, lis, testSecret)
+	ctx := context.Background()
+
+	devs, err := bc.ListDevices(ctx, &btgrpc.ListDevicesRequest{SortBy: btgrpc.ListDevicesRequest_NAME})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, d := range devs {
+		names = append(names, d.Name)
+	}
+	if want := []string{"Headphones", "Mouse", "Speaker"}; !slices.Equal(names, want) {
+		t.Fatalf("names = %v, want %v", names, want)
+	}
+
+	trusted, err := bc.GetTrustedDevices(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(trusted) != 2 {
+		t.Fatalf("got %d trusted devices, want 2", len(trusted))
+	}
+
+	connected := true
+	devs, err = bc.ListDevices(ctx, &btgrpc.ListDevicesRequest{Filter: &btgrpc.DeviceFilter{Connected: &connected}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(devs) != 1 || devs[0].Address != "AA:AA:AA:AA:AA:01" {
+		t.Fatalf("connected devices = %v, want the headphones", devs)
+	}
+	if devs[0].Battery.GetPercentage() != 80 {
+		t.Fatalf("battery = %v, want 80", devs[0].Battery)
+	}
+}
+
+func TestGetDevice(t *testing.T) {
+	headphones, speaker := newTestDevices()
+	lis := testServer(t, fake.NewAdapters(fake.NewAdapter(headphones, speaker)), "")
+	bc := testClient(t, lis, testSecret)
+	ctx := context.Background()
+
+	d, err := bc.GetDevice(ctx, "AA:AA:AA:AA:AA:02")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.Name != "Speaker" {
+		t.Fatalf("name = %q, want Speaker", d.Name)
+	}
+
+	for addr, want := range map[string]bool{"AA:AA:AA:AA:AA:01": true, "AA:AA:AA:AA:AA:02": false, "AA:AA:AA:AA:AA:99": false} {
+		connected, err := bc.IsConnected(ctx, addr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if connected != want {
+			t.Fatalf("%s connected = %t, want %t", addr, connected, want)
+		}
+	}
+}
+
+func TestConnectToDevice(t *testing.T) {
+	headphones, speaker := newTestDevices()
+	lis := testServer(t, fake.NewAdapters(fake.NewAdapter(headphones, speaker)), "")
+	bc := testClient(t, lis, testSecret)
+	ctx := context.Background()
+
+	if err := bc.ConnectToDevice(ctx, "AA:AA:AA:AA:AA:02"); err != nil {
+		t.Fatal(err)
+	}
+	if connected, _ := speaker.GetConnected(); !connected {
+		t.Fatal("speaker is not connected")
+	}
+
+	err := bc.ConnectToDevice(ctx, "AA:AA:AA:AA:AA:99")
+	info := assertStatus(t, err, codes.NotFound, bluetooth.ReasonDeviceNotFound)
+	if info.Metadata["address"] != "AA:AA:AA:AA:AA:99" {
+		t.Fatalf("address = %q, want AA:AA:AA:AA:AA:99", info.Metadata["address"])
+	}
+
+	headphones.FailConnect(dbus.Error{Name: "org.bluez.Error.Failed", Body: []interface{}{"Host is down"}})
+	err = bc.ConnectToDevice(ctx, "AA:AA:AA:AA:AA:01")
+	info = assertStatus(t, err, codes.Unavailable, bluetooth.ReasonDeviceUnavailable)
+	if info.Metadata["dbusError"] != "org.bluez.Error.Failed" {
+		t.Fatalf("dbusError = %q, want org.bluez.Error.Failed", info.Metadata["dbusError"])
+	}
+
+	err = bc.ConnectToDevice(ctx, "AA:AA:AA:AA:AA:01", bluetooth.OnAdapter("hci9"))
+	assertStatus(t, err, codes.NotFound, bluetooth.ReasonAdapterNotFound)
+}
+
+func TestConnectPowersOn(t *testing.T) {
+	_, speaker := newTestDevices()
+	a := fake.NewAdapter(speaker)
+	a.UpdateAdapter(func(p *fake.AdapterProperties) { p.Powered = false })
+	lis := testServer(t, fake.NewAdapters(a), "")
+	bc := testClient(t, lis, testSecret)
+	ctx := context.Background()
+
+	err := bc.ConnectToDevice(ctx, "AA:AA:AA:AA:AA:02")
+	assertStatus(t, err, codes.FailedPrecondition, bluetooth.ReasonAdapterNotReady)
+
+	// The connect waits until the adapter reports being powered
+	a.DelayPowerOn(100 * time.Millisecond)
+	if err := bc.ConnectToDevice(ctx, "AA:AA:AA:AA:AA:02", bluetooth.WithPowerOn()); err != nil {
+		t.Fatal(err)
+	}
+	if !a.AdapterProperties().Powered {
+		t.Fatal("connected before the adapter was powered")
+	}
+
+	speaker.Update(func(p *fake.Properties) { p.Connected = false })
+	a.UpdateAdapter(func(p *fake.AdapterProperties) { p.Powered = false })
+	a.DelayPowerOn(-1)
+	tctx, cancel := context.WithTimeout(ctx, 300*time.Millisecond)
+	defer cancel()
+	// An adapter that never powers on holds the connect until its deadline
+	err = bc.ConnectToDevice(tctx, "AA:AA:AA:AA:AA:02", bluetooth.WithPowerOn())
+	if status.Code(err) != codes.DeadlineExceeded {
+		t.Fatalf("connect with an adapter that stays off = %v, want DeadlineExceeded", err)
+	}
+	if connected, _ := speaker.GetConnected(); connected {
+		t.Fatal("connected while the adapter is off")
+	}
+}
+
+func TestAuthentication(t *testing.T) {
+	headphones, _ := newTestDevices()
+	lis := testServer(t, fake.NewAdapters(fake.NewAdapter(headphones)), "")
+	ctx := context.Background()
+
+	_, err := testClient(t, lis, "wrong").GetTrustedDevices(ctx)
+	assertStatus(t, err, codes.Unauthenticated, bluetooth.ReasonUnauthenticated)
+
+	// Streams are checked by their own interceptor
+	ch, err := testClient(t, lis, "").WatchDevices(ctx)
+	if err == nil {
+		_, ok := <-ch
+		if ok {
+			t.Fatal("watching without a secret sent a device")
+		}
+	} else {
+		assertStatus(t, err, codes.Unauthenticated, bluetooth.ReasonUnauthenticated)
+	}
+
+	if _, err := testClient(t, lis, testSecret).GetTrustedDevices(ctx); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPairClientRejectsEmptyName(t *testing.T) {
+	lis := testServer(t, fake.NewAdapters(fake.NewAdapter()), "")
+	bc := testClient(t, lis, "")
+
+	err := bc.Pair(context.Background(), " ", func() (string, error) {
+		t.Fatal("asked for the code of a pairing that was not started")
+		return "", nil
+	})
+	assertStatus(t, err, codes.InvalidArgument, bluetooth.ReasonInvalidName)
+}
+
+func TestACL(t *testing.T) {
+	headphones, speaker := newTestDevices()
+	lis := testServer(t, fake.NewAdapters(fake.NewAdapter(headphones, speaker)), `{
+		"default": "allow",
+		"rules": [
+			{"operations": ["ConnectToDevice"], "devices": ["Speaker"], "action": "deny"},
+			{"operations": ["RemoveDevice"], "action": "deny"}
+		]
+	}`)
+	bc := testClient(t, lis, testSecret)
+	ctx := context.Background()
+
+	// Denied for the device by the handler
+	err := bc.ConnectToDevice(ctx, "AA:AA:AA:AA:AA:02")
+	info := assertStatus(t, err, codes.PermissionDenied, bluetooth.ReasonPermissionDenied)
+	if info.Metadata["address"] != "AA:AA:AA:AA:AA:02" {
+		t.Fatalf("address = %q, want AA:AA:AA:AA:AA:02", info.Metadata["address"])
+	}
+	if connected, _ := speaker.GetConnected(); connected {
+		t.Fatal("speaker connected although denied")
+	}
+	if err := bc.ConnectToDevice(ctx, "AA:AA:AA:AA:AA:01"); err != nil {
+		t.Fatal(err)
+	}
+
+	// Denied for every device by the interceptor
+	err = bc.RemoveDevice(ctx, "AA:AA:AA:AA:AA:01")
+	info = assertStatus(t, err, codes.PermissionDenied, bluetooth.ReasonPermissionDenied)
+	if info.Metadata["operation"] != "RemoveDevice" {
+		t.Fatalf("operation = %q, want RemoveDevice", info.Metadata["operation"])
+	}
+}
+
+func TestWatchDevices(t *testing.T) {
+	headphones, _ := newTestDevices()
+	a := fake.NewAdapter(headphones)
+	lis := testServer(t, fake.NewAdapters(a), `{
+		"default": "allow",
+		"rules": [{"operations": ["GetTrustedDevices"], "devices": ["Secret*"], "action": "deny"}]
+	}`)
+	bc := testClient(t, lis, testSecret)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ch, err := bc.WatchDevices(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The stream is open once the server sends the changes of the headphones
+	connected := false
+	d := receiveAfter(t, ch, func() {
+		connected = !connected
+		headphones.Update(func(p *fake.Properties) { p.Connected = connected })
+	})
+	if d.Address != "AA:AA:AA:AA:AA:01" {
+		t.Fatalf("got %s, want the headphones", d.Address)
+	}
+
+	// Devices paired after the stream opened are sent as well, unless the ACL hides them
+	hidden := fake.NewDevice(fake.Properties{Address: "AA:AA:AA:AA:AA:04", Name: "Secret keyboard"})
+	a.AddDevice(hidden)
+	hidden.Update(func(p *fake.Properties) { p.Trusted = true })
+	added := fake.NewDevice(fake.Properties{Address: "AA:AA:AA:AA:AA:03", Name: "Mouse"})
+	a.AddDevice(added)
+	added.Update(func(p *fake.Properties) { p.Trusted, p.Paired = true, true })
+	for {
+		d := receiveAfter(t, ch, func() {})
+		if d.Address == "AA:AA:AA:AA:AA:04" {
+			t.Fatal("got the device the ACL hides")
+		}
+		if d.Address == "AA:AA:AA:AA:AA:03" {
+			if !d.Trusted {
+				t.Fatal("got the mouse before it was trusted")
+			}
+			return
+		}
+	}
+}
+
+// receiveAfter calls poke until a value is received
+func receiveAfter[T any](t *testing.T, ch <-chan T, poke func()) T {
+	t.Helper()
+
+	ticker := time.NewTicker(20 * time.Millisecond)
+	defer ticker.Stop()
+	timeout := time.After(2 * time.Second)
+
+	poke()
+	for {
+		select {
+		case d, ok := <-ch:
+			if !ok {
+				t.Fatal("stream closed")
+			}
+			return d
+		case <-ticker.C:
+			poke()
+		case <-timeout:
+			t.Fatal("timed out waiting for a value")
+		}
+	}
+}
+
+func TestPairingAgentRegistration(t *testing.T) {
+	a := fake.NewAdapter()
+	lis := testServer(t, fake.NewAdapters(a), "")
+	bc := testClient(t, lis, testSecret)
+
+	// Without a client running PairingAgent the agent is left to BlueZ
+	if _, err := bc.ListDevices(context.Background(), &btgrpc.ListDevicesRequest{}); err != nil {
+		t.Fatal(err)
+	}
+	if a.Agent() != nil {
+		t.Fatal("agent registered without a PairingAgent stream")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- bc.PairingAgent(ctx, func(context.Context, *btgrpc.AgentRequest) *btgrpc.AgentResponse { return nil })
+	}()
+	waitFor(t, func() bool { return a.Agent() != nil })
+
+	cancel()
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+	waitFor(t, func() bool { return a.Agent() == nil })
+}
+
+func TestBatteryAlertsWithoutSampling(t *testing.T) {
+	headphones, _ := newTestDevices()
+	lis := testServer(t, fake.NewAdapters(fake.NewAdapter(headphones)), "")
+	bc := testClient(t, lis, testSecret)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	alerts, err := bc.WatchBatteryAlerts(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Charging in between arms the threshold again, until the stream is open
+	low := false
+	alert := receiveAfter(t, alerts, func() {
+		low = !low
+		level := byte(50)
+		if low {
+			level = 15
+		}
+		headphones.Update(func(p *fake.Properties) { p.Battery = &level })
+	})
+	if alert.Threshold != 20 || alert.Percentage != 15 {
+		t.Fatalf("alert at %d%% for threshold %d, want 15%% for 20", alert.Percentage, alert.Threshold)
+	}
+}
+
+// waitFor fails unless cond holds within a couple of seconds
+func waitFor(t *testing.T, cond func() bool) {
+	t.Helper()
+
+	timeout := time.After(2 * time.Second)
+	for !cond() {
+		select {
+		case <-timeout:
+			t.Fatal("timed out waiting for the condition")
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+}
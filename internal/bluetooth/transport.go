@@ -0,0 +1,144 @@
+package bluetooth
+
+import (
+	"errors"
+	"sort"
+	"strings"
+
+	"github.com/godbus/dbus/v5"
+	"github.com/muka/go-bluetooth/bluez"
+	"github.com/muka/go-bluetooth/bluez/profile/media"
+)
+
+// MaxVolume is the highest absolute volume AVRCP allows
+const MaxVolume = 127
+
+var (
+	ErrMediaTransportNotFound = errors.New("media transport not found")
+	ErrVolumeNotSupported     = errors.New("media transport has no volume")
+	ErrInvalidVolume          = errors.New("volume must be between 0 and 127")
+)
+
+// MediaTransport is an audio stream between the server and a device, BlueZ has one for every connected
+// audio profile.
+type MediaTransport interface {
+	// GetID returns the path of the transport below the device, e.g. sep1/fd0
+	GetID() string
+	// GetUUID returns the UUID of the profile the transport belongs to
+	GetUUID() (string, error)
+	GetCodec() (byte, error)
+	// GetState returns idle, pending or active
+	GetState() (string, error)
+	// GetVolume returns the absolute volume, between 0 and MaxVolume, or ErrVolumeNotSupported if the
+	// device does not support it
+	GetVolume() (uint16, error)
+	SetVolume(volume uint16) error
+
+	// WatchChanges signals on the returned channel whenever a property of the transport changes.
+	// Calling stop ends the subscription.
+	WatchChanges() (changes <-chan struct{}, stop func(), err error)
+}
+
+// GetMediaTransports returns the transports below the device object, sorted by id
+func (d *bluezDevice) GetMediaTransports() ([]MediaTransport, error) {
+	om, err := bluez.GetObjectManager()
+	if err != nil {
+		return nil, err
+	}
+	objects, err := om.GetManagedObjects()
+	if err != nil {
+		return nil, err
+	}
+
+	var paths []string
+	prefix := string(d.Path()) + "/"
+	for p, ifaces := range objects {
+		if _, ok := ifaces[media.MediaTransport1Interface]; ok && strings.HasPrefix(string(p), prefix) {
+			paths = append(paths, string(p))
+		}
+	}
+	sort.Strings(paths)
+
+	transports := make([]MediaTransport, 0, len(paths))
+	for _, p := range paths {
+		transports = append(transports, &bluezMediaTransport{
+			id:     strings.TrimPrefix(p, prefix),
+			path:   dbus.ObjectPath(p),
+			client: bluez.NewClient(&bluez.Config{Name: bluez.OrgBluezInterface, Iface: media.MediaTransport1Interface, Path: dbus.ObjectPath(p), Bus: bluez.SystemBus}),
+		})
+	}
+
+	return transports, nil
+}
+
+type bluezMediaTransport struct {
+	id     string
+	path   dbus.ObjectPath
+	client *bluez.Client
+}
+
+var _ MediaTransport = (*bluezMediaTransport)(nil)
+
+func (t *bluezMediaTransport) GetID() string {
+	return t.id
+}
+
+func (t *bluezMediaTransport) GetUUID() (string, error) {
+	v, err := t.client.GetProperty("UUID")
+	if err != nil {
+		return "", err
+	}
+	uuid, _ := v.Value().(string)
+
+	return strings.ToLower(uuid), nil
+}
+
+func (t *bluezMediaTransport) GetCodec() (byte, error) {
+	v, err := t.client.GetProperty("Codec")
+	if err != nil {
+		return 0, err
+	}
+	codec, _ := v.Value().(byte)
+
+	return codec, nil
+}
+
+func (t *bluezMediaTransport) GetState() (string, error) {
+	v, err := t.client.GetProperty("State")
+	if err != nil {
+		return "", err
+	}
+	state, _ := v.Value().(string)
+
+	return state, nil
+}
+
+// GetVolume treats a missing Volume property as unsupported, BlueZ only has it for devices with
+// absolute volume
+func (t *bluezMediaTransport) GetVolume() (uint16, error) {
+	v, err := t.client.GetProperty("Volume")
+	if name, _, ok := dbusError(err); ok && name == "org.freedesktop.DBus.Error.InvalidArgs" {
+		return 0, ErrVolumeNotSupported
+	}
+	if err != nil {
+		return 0, err
+	}
+	volume, _ := v.Value().(uint16)
+
+	return volume, nil
+}
+
+func (t *bluezMediaTransport) SetVolume(volume uint16) error {
+	if volume > MaxVolume {
+		return ErrInvalidVolume
+	}
+	if _, err := t.GetVolume(); err != nil {
+		return err
+	}
+
+	return t.client.SetProperty("Volume", volume)
+}
+
+func (t *bluezMediaTransport) WatchChanges() (<-chan struct{}, func(), error) {
+	return watchProperties(t.path, media.MediaTransport1Interface)
+}
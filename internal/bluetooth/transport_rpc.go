@@ -0,0 +1,235 @@
+package bluetooth
+
+import (
+	"context"
+
+	btgrpc "github.com/andree-bjorkgard/remote-bluetooth/internal/bluetooth/grpc"
+)
+
+// States MediaTransport1 reports
+var transportStates = map[string]btgrpc.MediaTransport_State{
+	"idle":    btgrpc.MediaTransport_IDLE,
+	"pending": btgrpc.MediaTransport_PENDING,
+	"active":  btgrpc.MediaTransport_ACTIVE,
+}
+
+func (s *BluetoothServer) ListMediaTransports(ctx context.Context, request *btgrpc.DeviceRequest) (*btgrpc.MediaTransports, error) {
+	dev, err := s.allowedDevice(ctx, "ListMediaTransports", request.AdapterId, request.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	transports, err := dev.GetMediaTransports()
+	if err != nil {
+		return nil, deviceError(err, request.Address)
+	}
+
+	resp := &btgrpc.MediaTransports{}
+	for _, t := range transports {
+		gt, err := grpcTransport(request.Address, t)
+		if err != nil {
+			return nil, deviceError(err, request.Address)
+		}
+		resp.Transports = append(resp.Transports, gt)
+	}
+
+	return resp, nil
+}
+
+func (s *BluetoothServer) GetVolume(ctx context.Context, request *btgrpc.TransportRequest) (*btgrpc.MediaTransport, error) {
+	return s.onTransport(ctx, "GetVolume", request.AdapterId, request.Address, request.TransportId, func(_ MediaTransport) error {
+		return nil
+	})
+}
+
+func (s *BluetoothServer) SetVolume(ctx context.Context, request *btgrpc.SetVolumeRequest) (*btgrpc.MediaTransport, error) {
+	if request.Volume > MaxVolume {
+		return nil, deviceError(ErrInvalidVolume, request.Address)
+	}
+
+	return s.onTransport(ctx, "SetVolume", request.AdapterId, request.Address, request.TransportId, func(t MediaTransport) error {
+		return t.SetVolume(uint16(request.Volume))
+	})
+}
+
+// WatchVolume follows the transports of the device, which are looked up again whenever the device
+// changes since they come and go with the audio profiles
+func (s *BluetoothServer) WatchVolume(request *btgrpc.DeviceRequest, stream btgrpc.Bluetooth_WatchVolumeServer) error {
+	ctx := stream.Context()
+	dev, err := s.allowedDevice(ctx, "WatchVolume", request.AdapterId, request.Address)
+	if err != nil {
+		return err
+	}
+
+	transports, err := dev.GetMediaTransports()
+	if err != nil {
+		return deviceError(err, request.Address)
+	}
+	if len(transports) == 0 {
+		return deviceError(ErrMediaTransportNotFound, request.Address)
+	}
+
+	devChanges, stopDev, err := dev.WatchChanges()
+	if err != nil {
+		return deviceError(err, request.Address)
+	}
+	defer stopDev()
+
+	sent := make(map[string]*btgrpc.MediaTransport)
+	send := func() error {
+		for _, t := range transports {
+			// A transport that is gone is dropped once the device changes
+			gt, err := grpcTransport(request.Address, t)
+			if err != nil {
+				continue
+			}
+			if last, ok := sent[gt.Id]; ok && sameVolume(last.Volume, gt.Volume) {
+				continue
+			}
+			sent[gt.Id] = gt
+
+			if err := stream.Send(gt); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	for {
+		changes, stop, err := watchTransports(transports)
+		if err != nil {
+			return deviceError(err, request.Address)
+		}
+		err = follow(ctx, changes, devChanges, send)
+		stop()
+		if err != nil || ctx.Err() != nil {
+			return err
+		}
+
+		transports, err = dev.GetMediaTransports()
+		if err != nil {
+			return deviceError(err, request.Address)
+		}
+		if len(transports) == 0 {
+			return nil
+		}
+	}
+}
+
+// watchTransports signals on the returned channel whenever one of the transports changes
+func watchTransports(transports []MediaTransport) (<-chan struct{}, func(), error) {
+	changes := make(chan struct{}, 1)
+	done := make(chan struct{})
+
+	var stops []func()
+	stop := func() {
+		close(done)
+		for _, s := range stops {
+			s()
+		}
+	}
+
+	for _, t := range transports {
+		tc, ts, err := t.WatchChanges()
+		if err != nil {
+			stop()
+			return nil, nil, err
+		}
+		stops = append(stops, ts)
+
+		go func() {
+			for {
+				select {
+				case <-done:
+					return
+				case <-tc:
+				}
+
+				select {
+				case changes <- struct{}{}:
+				default:
+				}
+			}
+		}()
+	}
+
+	return changes, stop, nil
+}
+
+func sameVolume(a, b *uint32) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return *a == *b
+}
+
+// onTransport runs fn on the transport of the device and returns its new state
+func (s *BluetoothServer) onTransport(ctx context.Context, operation, adapterID, address, transportID string, fn func(t MediaTransport) error) (*btgrpc.MediaTransport, error) {
+	dev, err := s.allowedDevice(ctx, operation, adapterID, address)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := mediaTransport(dev, transportID)
+	if err != nil {
+		return nil, deviceError(err, address)
+	}
+	if err := fn(t); err != nil {
+		return nil, deviceError(err, address)
+	}
+
+	gt, err := grpcTransport(address, t)
+	if err != nil {
+		return nil, deviceError(err, address)
+	}
+
+	return gt, nil
+}
+
+// mediaTransport returns the transport with the id, or the first one with a volume if the id is empty
+func mediaTransport(dev Device, id string) (MediaTransport, error) {
+	transports, err := dev.GetMediaTransports()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, t := range transports {
+		if id == "" {
+			if _, err := t.GetVolume(); err == nil {
+				return t, nil
+			}
+		} else if t.GetID() == id {
+			return t, nil
+		}
+	}
+	if id == "" && len(transports) > 0 {
+		return nil, ErrVolumeNotSupported
+	}
+
+	return nil, ErrMediaTransportNotFound
+}
+
+func grpcTransport(address string, t MediaTransport) (*btgrpc.MediaTransport, error) {
+	uuid, err := t.GetUUID()
+	if err != nil {
+		return nil, err
+	}
+	state, _ := t.GetState()
+	codec, _ := t.GetCodec()
+
+	gt := &btgrpc.MediaTransport{
+		Id:      t.GetID(),
+		Address: address,
+		Profile: &btgrpc.Profile{Uuid: uuid, Name: profileName(uuid)},
+		State:   transportStates[state],
+		Codec:   uint32(codec),
+	}
+	if volume, err := t.GetVolume(); err == nil {
+		v := uint32(volume)
+		gt.Volume = &v
+	}
+
+	return gt, nil
+}
@@ -0,0 +1,142 @@
+package bluetooth_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/andree-bjorkgard/remote-bluetooth/internal/bluetooth"
+	"github.com/andree-bjorkgard/remote-bluetooth/internal/bluetooth/fake"
+)
+
+const (
+	hfpUUID  = "0000111e-0000-1000-8000-00805f9b34fb"
+	a2dpUUID = "0000110b-0000-1000-8000-00805f9b34fb"
+)
+
+// newTestTransports returns an HFP transport without a volume and an A2DP transport at volume
+func newTestTransports(volume uint16) (*fake.MediaTransport, *fake.MediaTransport) {
+	hfp := fake.NewMediaTransport(fake.TransportProperties{ID: "sep1/fd0", UUID: hfpUUID, State: "active"})
+	a2dp := fake.NewMediaTransport(fake.TransportProperties{ID: "sep2/fd1", UUID: a2dpUUID, State: "active", Volume: &volume})
+
+	return hfp, a2dp
+}
+
+func TestVolume(t *testing.T) {
+	headphones, speaker := newTestDevices()
+	hfp, a2dp := newTestTransports(50)
+	headphones.SetMediaTransports(hfp, a2dp)
+	lis := testServer(t, fake.NewAdapters(fake.NewAdapter(headphones, speaker)), "")
+	bc := testClient(t, lis, testSecret)
+	ctx := context.Background()
+
+	// Without a transport id the first transport with a volume is used
+	tr, err := bc.GetVolume(ctx, "AA:AA:AA:AA:AA:01")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tr.Id != "sep2/fd1" || tr.GetVolume() != 50 {
+		t.Fatalf("transport %s at %d, want sep2/fd1 at 50", tr.Id, tr.GetVolume())
+	}
+
+	if tr, err = bc.SetVolume(ctx, "AA:AA:AA:AA:AA:01", bluetooth.MaxVolume); err != nil {
+		t.Fatal(err)
+	}
+	if tr.GetVolume() != bluetooth.MaxVolume {
+		t.Fatalf("volume = %d, want %d", tr.GetVolume(), bluetooth.MaxVolume)
+	}
+
+	// Volumes above the AVRCP maximum are rejected rather than clamped
+	_, err = bc.SetVolume(ctx, "AA:AA:AA:AA:AA:01", bluetooth.MaxVolume+1)
+	assertStatus(t, err, codes.InvalidArgument, bluetooth.ReasonInvalidVolume)
+	if v := *a2dp.TransportProperties().Volume; v != bluetooth.MaxVolume {
+		t.Fatalf("volume = %d after an invalid volume, want %d", v, bluetooth.MaxVolume)
+	}
+
+	_, err = bc.SetVolume(ctx, "AA:AA:AA:AA:AA:01", 10, bluetooth.OnTransport("sep1/fd0"))
+	assertStatus(t, err, codes.FailedPrecondition, bluetooth.ReasonVolumeNotSupported)
+
+	_, err = bc.GetVolume(ctx, "AA:AA:AA:AA:AA:01", bluetooth.OnTransport("sep9/fd9"))
+	assertStatus(t, err, codes.NotFound, bluetooth.ReasonTransportNotFound)
+
+	// The speaker is not connected, so it has no transport at all
+	_, err = bc.GetVolume(ctx, "AA:AA:AA:AA:AA:02")
+	assertStatus(t, err, codes.NotFound, bluetooth.ReasonTransportNotFound)
+
+	// A device with only transports without a volume
+	headphones.SetMediaTransports(hfp)
+	_, err = bc.GetVolume(ctx, "AA:AA:AA:AA:AA:01")
+	assertStatus(t, err, codes.FailedPrecondition, bluetooth.ReasonVolumeNotSupported)
+}
+
+func TestWatchVolume(t *testing.T) {
+	headphones, _ := newTestDevices()
+	hfp, a2dp := newTestTransports(50)
+	headphones.SetMediaTransports(hfp, a2dp)
+	lis := testServer(t, fake.NewAdapters(fake.NewAdapter(headphones)), "")
+	bc := testClient(t, lis, testSecret)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ch, err := bc.WatchVolume(ctx, "AA:AA:AA:AA:AA:01")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Every transport is sent first
+	got := make(map[string]bool)
+	for len(got) < 2 {
+		tr := receiveAfter(t, ch, func() {})
+		got[tr.Id] = true
+	}
+	if !got["sep1/fd0"] || !got["sep2/fd1"] {
+		t.Fatalf("got transports %v, want sep1/fd0 and sep2/fd1", got)
+	}
+
+	volume := uint16(60)
+	a2dp.Update(func(p *fake.TransportProperties) { p.Volume = &volume })
+	tr := receiveAfter(t, ch, func() {})
+	if tr.Id != "sep2/fd1" || tr.GetVolume() != 60 {
+		t.Fatalf("transport %s at %d, want sep2/fd1 at 60", tr.Id, tr.GetVolume())
+	}
+
+	// Changes other than the volume are not sent
+	a2dp.Update(func(p *fake.TransportProperties) { p.State = "idle" })
+	volume = 70
+	a2dp.Update(func(p *fake.TransportProperties) { p.Volume = &volume })
+	if tr := receiveAfter(t, ch, func() {}); tr.GetVolume() != 70 {
+		t.Fatalf("volume = %d, want 70", tr.GetVolume())
+	}
+
+	// The stream ends with the last transport
+	headphones.SetMediaTransports()
+	select {
+	case tr, ok := <-ch:
+		if ok {
+			t.Fatalf("got transport %s after the transports were removed", tr.Id)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("stream not closed after the transports were removed")
+	}
+}
+
+func TestWatchVolumeWithoutTransport(t *testing.T) {
+	_, speaker := newTestDevices()
+	lis := testServer(t, fake.NewAdapters(fake.NewAdapter(speaker)), "")
+	bc := testClient(t, lis, testSecret)
+
+	ch, err := bc.WatchVolume(context.Background(), "AA:AA:AA:AA:AA:02")
+	if err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case tr, ok := <-ch:
+		if ok {
+			t.Fatalf("got transport %s of a device without any", tr.Id)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("stream not closed for a device without transports")
+	}
+}
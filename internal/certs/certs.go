@@ -0,0 +1,203 @@
+// Package certs generates a small private CA with per-host certificates and builds TLS configurations from them.
+package certs
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/fs"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// DefaultServerName is added to every generated server certificate and used by clients to verify servers,
+// since servers are discovered by IP address and those tend to change.
+const DefaultServerName = "remote-bluetooth"
+
+const (
+	CAFile    = "ca.pem"
+	CAKeyFile = "ca-key.pem"
+
+	caValidity   = 10 * 365 * 24 * time.Hour
+	certValidity = 2 * 365 * 24 * time.Hour
+)
+
+// GenerateCA creates a new certificate authority in dir. Existing files are only replaced if force is set,
+// since replacing the CA invalidates every certificate it signed.
+func GenerateCA(dir string, force bool) error {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return fmt.Errorf("certs.GenerateCA: %w", err)
+	}
+
+	serial, err := randomSerial()
+	if err != nil {
+		return fmt.Errorf("certs.GenerateCA: %w", err)
+	}
+
+	tmpl := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{CommonName: "remote-bluetooth CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(caValidity),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		return fmt.Errorf("certs.GenerateCA: %w", err)
+	}
+
+	if err := writePair(filepath.Join(dir, CAFile), filepath.Join(dir, CAKeyFile), der, key, force); err != nil {
+		return fmt.Errorf("certs.GenerateCA: %w", err)
+	}
+
+	return nil
+}
+
+// GenerateCertificate creates a certificate for name signed by the CA in dir. Client certificates can only
+// authenticate clients. Server certificates also authenticate servers, which query their peers as clients, and
+// carry DefaultServerName and hosts as subject alternative names, IP addresses as IP SANs and everything else
+// as DNS names. The certificate and key are written to <name>.pem and <name>-key.pem in dir, existing files
+// are only replaced if force is set.
+func GenerateCertificate(dir, name string, hosts []string, server, force bool) error {
+	if len(hosts) > 0 && !server {
+		return fmt.Errorf("certs.GenerateCertificate: hosts are only added to server certificates")
+	}
+
+	ca, caKey, err := loadCA(dir)
+	if err != nil {
+		return fmt.Errorf("certs.GenerateCertificate: %w", err)
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return fmt.Errorf("certs.GenerateCertificate: %w", err)
+	}
+
+	serial, err := randomSerial()
+	if err != nil {
+		return fmt.Errorf("certs.GenerateCertificate: %w", err)
+	}
+
+	tmpl := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(certValidity),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	if server {
+		tmpl.ExtKeyUsage = append(tmpl.ExtKeyUsage, x509.ExtKeyUsageServerAuth)
+		tmpl.DNSNames = []string{DefaultServerName}
+		for _, h := range hosts {
+			if ip := net.ParseIP(h); ip != nil {
+				tmpl.IPAddresses = append(tmpl.IPAddresses, ip)
+			} else {
+				tmpl.DNSNames = append(tmpl.DNSNames, h)
+			}
+		}
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca, &key.PublicKey, caKey)
+	if err != nil {
+		return fmt.Errorf("certs.GenerateCertificate: %w", err)
+	}
+
+	if err := writePair(filepath.Join(dir, name+".pem"), filepath.Join(dir, name+"-key.pem"), der, key, force); err != nil {
+		return fmt.Errorf("certs.GenerateCertificate: %w", err)
+	}
+
+	return nil
+}
+
+func loadCA(dir string) (*x509.Certificate, *ecdsa.PrivateKey, error) {
+	certPEM, err := os.ReadFile(filepath.Join(dir, CAFile))
+	if err != nil {
+		return nil, nil, err
+	}
+	keyPEM, err := os.ReadFile(filepath.Join(dir, CAKeyFile))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	certBlock, _ := pem.Decode(certPEM)
+	if certBlock == nil {
+		return nil, nil, fmt.Errorf("no certificate found in %s", CAFile)
+	}
+	cert, err := x509.ParseCertificate(certBlock.Bytes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	keyBlock, _ := pem.Decode(keyPEM)
+	if keyBlock == nil {
+		return nil, nil, fmt.Errorf("no key found in %s", CAKeyFile)
+	}
+	key, err := x509.ParseECPrivateKey(keyBlock.Bytes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return cert, key, nil
+}
+
+// writePair writes the certificate and its key. Unless force is set it fails with fs.ErrExist before writing
+// anything if either file exists.
+func writePair(certPath, keyPath string, der []byte, key *ecdsa.PrivateKey, force bool) error {
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return err
+	}
+
+	for _, p := range []string{certPath, keyPath} {
+		_, err := os.Lstat(p)
+		switch {
+		case err == nil && !force:
+			return &fs.PathError{Op: "create", Path: p, Err: fs.ErrExist}
+		case err == nil:
+			// Removed rather than truncated, so the new file gets its own permissions
+			if err := os.Remove(p); err != nil {
+				return err
+			}
+		case !errors.Is(err, fs.ErrNotExist):
+			return err
+		}
+	}
+
+	if err := writeNew(certPath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0644); err != nil {
+		return err
+	}
+
+	return writeNew(keyPath, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0600)
+}
+
+// writeNew writes a file that must not exist yet
+func writeNew(path string, data []byte, perm fs.FileMode) error {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, perm)
+	if err != nil {
+		return err
+	}
+
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
+func randomSerial() (*big.Int, error) {
+	return rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+}
@@ -0,0 +1,143 @@
+package certs
+
+import (
+	"bytes"
+	"crypto/tls"
+	"errors"
+	"io/fs"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestGenerateRefusesToOverwrite(t *testing.T) {
+	dir := t.TempDir()
+	if err := GenerateCA(dir, false); err != nil {
+		t.Fatal(err)
+	}
+	ca := readFile(t, filepath.Join(dir, CAFile))
+
+	if err := GenerateCA(dir, false); !errors.Is(err, fs.ErrExist) {
+		t.Fatalf("GenerateCA over an existing CA = %v, want fs.ErrExist", err)
+	}
+	if !bytes.Equal(readFile(t, filepath.Join(dir, CAFile)), ca) {
+		t.Fatal("existing CA was changed")
+	}
+
+	if err := GenerateCertificate(dir, "server", []string{"127.0.0.1"}, true, false); err != nil {
+		t.Fatal(err)
+	}
+	// A missing certificate does not allow replacing its key
+	if err := os.Remove(filepath.Join(dir, "server.pem")); err != nil {
+		t.Fatal(err)
+	}
+	if err := GenerateCertificate(dir, "server", nil, true, false); !errors.Is(err, fs.ErrExist) {
+		t.Fatalf("GenerateCertificate over an existing key = %v, want fs.ErrExist", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "server.pem")); !errors.Is(err, fs.ErrNotExist) {
+		t.Fatal("certificate written although its key exists")
+	}
+}
+
+func TestGenerateForce(t *testing.T) {
+	dir := t.TempDir()
+	if err := GenerateCA(dir, false); err != nil {
+		t.Fatal(err)
+	}
+	ca := readFile(t, filepath.Join(dir, CAFile))
+
+	// A key that was readable by everyone is not left that way
+	if err := os.Chmod(filepath.Join(dir, CAKeyFile), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := GenerateCA(dir, true); err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Equal(readFile(t, filepath.Join(dir, CAFile)), ca) {
+		t.Fatal("CA was not replaced")
+	}
+	info, err := os.Stat(filepath.Join(dir, CAKeyFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if perm := info.Mode().Perm(); perm != 0600 {
+		t.Fatalf("key permissions = %o, want 600", perm)
+	}
+
+	if err := GenerateCertificate(dir, "server", nil, true, false); err != nil {
+		t.Fatal(err)
+	}
+	if err := GenerateCertificate(dir, "server", nil, true, true); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func readFile(t *testing.T, path string) []byte {
+	t.Helper()
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return b
+}
+
+func TestClientCertificateIsNoServerCertificate(t *testing.T) {
+	dir := t.TempDir()
+	if err := GenerateCA(dir, false); err != nil {
+		t.Fatal(err)
+	}
+	if err := GenerateCertificate(dir, "server", nil, true, false); err != nil {
+		t.Fatal(err)
+	}
+	if err := GenerateCertificate(dir, "laptop", nil, false, false); err != nil {
+		t.Fatal(err)
+	}
+	if err := GenerateCertificate(dir, "phone", []string{"10.0.0.2"}, false, false); err == nil {
+		t.Fatal("client certificate with hosts was created")
+	}
+
+	ca := filepath.Join(dir, CAFile)
+	serverCfg := func(name string) *tls.Config {
+		cfg, err := ServerConfig(filepath.Join(dir, name+".pem"), filepath.Join(dir, name+"-key.pem"), ca)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return cfg
+	}
+	clientCfg := func(name string) *tls.Config {
+		cfg, err := ClientConfig(filepath.Join(dir, name+".pem"), filepath.Join(dir, name+"-key.pem"), ca, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		return cfg
+	}
+
+	if err := handshake(serverCfg("server"), clientCfg("laptop")); err != nil {
+		t.Fatalf("client with a client certificate: %v", err)
+	}
+	// Servers query their peers, so a server certificate also authenticates a client
+	if err := handshake(serverCfg("server"), clientCfg("server")); err != nil {
+		t.Fatalf("client with a server certificate: %v", err)
+	}
+	// A client certificate must not let a laptop impersonate a server
+	if err := handshake(serverCfg("laptop"), clientCfg("server")); err == nil {
+		t.Fatal("a client certificate was accepted as server certificate")
+	}
+}
+
+// handshake runs a TLS handshake over an in-memory connection and returns the error of the client
+func handshake(serverCfg, clientCfg *tls.Config) error {
+	serverConn, clientConn := net.Pipe()
+	defer serverConn.Close()
+	defer clientConn.Close()
+
+	go func() {
+		tls.Server(serverConn, serverCfg).Handshake()
+		serverConn.Close()
+	}()
+
+	return tls.Client(clientConn, clientCfg).Handshake()
+}
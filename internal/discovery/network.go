@@ -0,0 +1,49 @@
+package discovery
+
+import (
+	"net"
+	"strings"
+)
+
+// localAddresses returns the IPv4 addresses of all non-loopback interfaces together with the broadcast address of their subnet
+func localAddresses() (local []net.IP, broadcast []net.IP, err error) {
+	ifs, err := net.Interfaces()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, i := range ifs {
+		addr, err := i.Addrs()
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, a := range addr {
+			ipAddr, ok := a.(*net.IPNet)
+			// Ignore loopback and ipv6 addresses
+			if ok && !ipAddr.IP.IsLoopback() && strings.Count(ipAddr.IP.String(), ":") < 2 {
+				local = append(local, ipAddr.IP)
+				broadcast = append(broadcast, subnetBroadcastIP(*ipAddr))
+			}
+		}
+	}
+
+	return local, broadcast, nil
+}
+
+func subnetBroadcastIP(ipnet net.IPNet) net.IP {
+	byteIp := []byte(ipnet.IP)
+	byteMask := []byte(ipnet.Mask)
+	// Using bytemask for length instead because IP is padded with zeros
+	byteBroadCastIP := make([]byte, len(byteMask))
+	byteIp = byteIp[len(byteIp)-len(byteMask):]
+
+	for k := range byteIp {
+		// mask will give us all fixed bits of the subnet (for the given byte)
+		// inverted mask will give us all moving bits of the subnet (for the given byte)
+		invertedMask := byteMask[k] ^ 0xff // inverted mask byte
+		// broadcastIP = networkIP added to the inverted mask
+		byteBroadCastIP[k] = byteIp[k]&byteMask[k] | invertedMask
+	}
+
+	return net.IP(byteBroadCastIP)
+}
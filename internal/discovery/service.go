@@ -2,6 +2,7 @@ package discovery
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"log"
 	"net"
@@ -26,7 +27,7 @@ func NewDiscoveryService(broadcastPort int, broadcastMessage, broadcastServerRes
 }
 
 // Client
-func (s *DiscoveryService) listenForServers() (addr <-chan string, port int) {
+func (s *DiscoveryService) listenForServers(ctx context.Context) (addr <-chan string, port int) {
 	ch := make(chan string, 10)
 	port, err := util.GetFreePort()
 	if err != nil {
@@ -34,16 +35,22 @@ func (s *DiscoveryService) listenForServers() (addr <-chan string, port int) {
 	}
 
 	go func(chan string) {
-		for {
-			msg, addr, err := s.listen(port)
+		defer close(ch)
+		for ctx.Err() == nil {
+			msg, addr, err := s.listen(ctx, port)
 			if err != nil {
-				log.Printf("DiscoveryService.listenForServers: %s", err)
+				if ctx.Err() == nil {
+					log.Printf("DiscoveryService.listenForServers: %s", err)
+				}
 				continue
 			}
 
 			if bytes.Equal(msg.getMessageBytes(), s.BroadcastServerResponse) {
 				addr.Port = msg.getPort()
-				ch <- addr.String()
+				select {
+				case ch <- addr.String():
+				case <-ctx.Done():
+				}
 			}
 		}
 	}(ch)
@@ -82,8 +89,9 @@ func (s *DiscoveryService) askForServers(broadcastIP net.IP, listenerPort int) e
 }
 
 // client
-func (s *DiscoveryService) Discover(broadcastIPs []net.IP) (addr <-chan string) {
-	ch, port := s.listenForServers()
+// The returned channel is closed once ctx is cancelled.
+func (s *DiscoveryService) Discover(ctx context.Context, broadcastIPs []net.IP) (addr <-chan string) {
+	ch, port := s.listenForServers(ctx)
 
 	for _, ip := range broadcastIPs {
 		err := s.askForServers(ip, port)
@@ -95,10 +103,50 @@ func (s *DiscoveryService) Discover(broadcastIPs []net.IP) (addr <-chan string)
 	return ch
 }
 
+// client
+// DiscoverRemote broadcasts on every local subnet and returns the addresses of all servers that answer,
+// except the ones running on this machine. The returned channel is closed once ctx is cancelled.
+func (s *DiscoveryService) DiscoverRemote(ctx context.Context) (addr <-chan string, err error) {
+	local, broadcastIPs, err := localAddresses()
+	if err != nil {
+		return nil, fmt.Errorf("DiscoveryService.DiscoverRemote: %w", err)
+	}
+
+	ch := make(chan string, 10)
+	found := s.Discover(ctx, broadcastIPs)
+
+	go func() {
+		defer close(ch)
+	main:
+		for addr := range found {
+			host, _, err := net.SplitHostPort(addr)
+			if err != nil {
+				log.Println("Invalid address: ", addr)
+				continue
+			}
+
+			for _, ip := range local {
+				if ip.String() == host {
+					log.Println("Ignoring local address: ", addr)
+					continue main
+				}
+			}
+
+			select {
+			case ch <- addr:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch, nil
+}
+
 // Server
 func (s *DiscoveryService) StartServerAnnouncer(serverPort int) {
 	for {
-		msg, addr, err := s.listen(s.BroadcastPort)
+		msg, addr, err := s.listen(context.Background(), s.BroadcastPort)
 		if err != nil {
 			log.Panicf("DiscoveryService.StartServerAnnouncer: %s", err)
 			continue
@@ -130,13 +178,17 @@ func (s *DiscoveryService) announce(serverPort int, addr net.Addr) {
 	}
 }
 
-func (s *DiscoveryService) listen(port int) (message, *net.UDPAddr, error) {
+// listen waits for a single packet, cancelling ctx closes the connection and aborts the wait
+func (s *DiscoveryService) listen(ctx context.Context, port int) (message, *net.UDPAddr, error) {
 	pc, err := net.ListenPacket("udp4", fmt.Sprintf(":%d", port))
 	if err != nil {
 		return nil, nil, fmt.Errorf("DiscoveryService.Listen: error while listening for packet: %w", err)
 	}
 	defer pc.Close()
 
+	stop := context.AfterFunc(ctx, func() { pc.Close() })
+	defer stop()
+
 	log.Printf("DiscoveryService.Listen: listening on port %d", port)
 
 	buf := make([]byte, 1024)
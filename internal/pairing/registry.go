@@ -0,0 +1,323 @@
+// Package pairing implements trust-on-first-use pairing of clients: the server keeps a registry of
+// clients it has issued tokens to, and clients keep a store of the tokens they were issued.
+package pairing
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	pairingTimeout     = 2 * time.Minute
+	maxConfirmAttempts = 3
+	// maxPending bounds the pairings waiting for their code, since anyone on the network can start one
+	maxPending = 10
+	// A peer may start maxPairingsPerPeer pairings per pairingRateWindow
+	maxPairingsPerPeer = 3
+	pairingRateWindow  = 10 * time.Minute
+)
+
+var (
+	ErrUnknownPairing  = errors.New("unknown or expired pairing")
+	ErrWrongCode       = errors.New("wrong pairing code")
+	ErrUnknownClient   = errors.New("unknown client")
+	ErrInvalidName     = errors.New("client name is empty")
+	ErrNameTaken       = errors.New("client name already paired or pairing")
+	ErrTooManyPairings = errors.New("too many pairings")
+)
+
+// Client is a paired client. Only a hash of its token is stored.
+type Client struct {
+	Name      string    `json:"name"`
+	TokenHash string    `json:"tokenHash"`
+	PairedAt  time.Time `json:"pairedAt"`
+}
+
+type pendingPairing struct {
+	clientName string
+	code       string
+	expires    time.Time
+	attempts   int
+}
+
+// Registry is the server side list of paired clients, persisted as JSON.
+type Registry struct {
+	mu      sync.Mutex
+	path    string
+	modTime time.Time
+
+	ServerID string   `json:"serverId"`
+	Clients  []Client `json:"clients"`
+
+	pending map[string]*pendingPairing
+	// started holds when each peer started its recent pairings
+	started map[string][]time.Time
+}
+
+// LoadRegistry reads the registry at path, creating it with a new server id if it does not exist.
+func LoadRegistry(path string) (*Registry, error) {
+	r := &Registry{path: path, pending: make(map[string]*pendingPairing), started: make(map[string][]time.Time)}
+
+	if err := r.reload(); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("pairing.LoadRegistry: %w", err)
+	}
+
+	if r.ServerID == "" {
+		id, err := randomHex(16)
+		if err != nil {
+			return nil, fmt.Errorf("pairing.LoadRegistry: %w", err)
+		}
+		r.ServerID = id
+		if err := r.save(); err != nil {
+			return nil, fmt.Errorf("pairing.LoadRegistry: %w", err)
+		}
+	}
+
+	return r, nil
+}
+
+// Authorize returns the name of the client the token was issued to.
+func (r *Registry) Authorize(token string) (name string, ok bool) {
+	if token == "" {
+		return "", false
+	}
+
+	hash := hashToken(token)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// Clients may have been revoked by another process
+	if err := r.reload(); err != nil {
+		log.Printf("Registry.Authorize: %s", err)
+	}
+
+	for _, c := range r.Clients {
+		if subtle.ConstantTimeCompare([]byte(c.TokenHash), []byte(hash)) == 1 {
+			return c.Name, true
+		}
+	}
+
+	return "", false
+}
+
+// Begin starts pairing a client and returns the pairing id and the 6-digit code the user has to confirm on the client.
+// peer is the host the request came from. The name must not be empty, paired or pairing already, and a peer can
+// only start a few pairings at a time.
+func (r *Registry) Begin(clientName, peer string) (id, code string, err error) {
+	// The name identifies the client in the ACL
+	if strings.TrimSpace(clientName) == "" {
+		return "", "", fmt.Errorf("Registry.Begin: %w", ErrInvalidName)
+	}
+
+	id, err = randomHex(16)
+	if err != nil {
+		return "", "", fmt.Errorf("Registry.Begin: %w", err)
+	}
+
+	n, err := rand.Int(rand.Reader, big.NewInt(1000000))
+	if err != nil {
+		return "", "", fmt.Errorf("Registry.Begin: %w", err)
+	}
+	code = fmt.Sprintf("%06d", n.Int64())
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	r.expirePending()
+
+	// Clients may have been revoked by another process
+	if err := r.reload(); err != nil && !errors.Is(err, os.ErrNotExist) {
+		log.Printf("Registry.Begin: %s", err)
+	}
+	if r.nameTaken(clientName) {
+		return "", "", fmt.Errorf("Registry.Begin: %w: %q", ErrNameTaken, clientName)
+	}
+
+	var recent []time.Time
+	for _, t := range r.started[peer] {
+		if now.Sub(t) < pairingRateWindow {
+			recent = append(recent, t)
+		}
+	}
+	if len(recent) >= maxPairingsPerPeer {
+		r.started[peer] = recent
+		return "", "", fmt.Errorf("Registry.Begin: %w from %s", ErrTooManyPairings, peer)
+	}
+	if len(r.pending) >= maxPending {
+		return "", "", fmt.Errorf("Registry.Begin: %w pending", ErrTooManyPairings)
+	}
+
+	r.started[peer] = append(recent, now)
+	r.pending[id] = &pendingPairing{clientName: clientName, code: code, expires: now.Add(pairingTimeout)}
+
+	return id, code, nil
+}
+
+func (r *Registry) nameTaken(name string) bool {
+	for _, c := range r.Clients {
+		if c.Name == name {
+			return true
+		}
+	}
+	for _, p := range r.pending {
+		if p.clientName == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Confirm finishes a pairing if code matches and returns the token issued to the client.
+// A pairing is dropped after a few wrong codes.
+func (r *Registry) Confirm(id, code string) (token string, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.expirePending()
+	p, ok := r.pending[id]
+	if !ok {
+		return "", ErrUnknownPairing
+	}
+
+	if subtle.ConstantTimeCompare([]byte(p.code), []byte(code)) != 1 {
+		p.attempts++
+		if p.attempts >= maxConfirmAttempts {
+			delete(r.pending, id)
+		}
+		return "", ErrWrongCode
+	}
+	delete(r.pending, id)
+
+	token, err = randomHex(32)
+	if err != nil {
+		return "", fmt.Errorf("Registry.Confirm: %w", err)
+	}
+
+	// Saving writes every client, so the clients revoked by another process must not be written back
+	if err := r.reload(); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return "", fmt.Errorf("Registry.Confirm: %w", err)
+	}
+	r.Clients = append(r.Clients, Client{Name: p.clientName, TokenHash: hashToken(token), PairedAt: time.Now()})
+	if err := r.save(); err != nil {
+		return "", fmt.Errorf("Registry.Confirm: %w", err)
+	}
+
+	return token, nil
+}
+
+// Revoke removes every client paired under name.
+func (r *Registry) Revoke(name string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var kept []Client
+	for _, c := range r.Clients {
+		if c.Name != name {
+			kept = append(kept, c)
+		}
+	}
+	if len(kept) == len(r.Clients) {
+		return ErrUnknownClient
+	}
+	r.Clients = kept
+
+	return r.save()
+}
+
+// List returns the paired clients.
+func (r *Registry) List() []Client {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]Client(nil), r.Clients...)
+}
+
+func (r *Registry) expirePending() {
+	now := time.Now()
+	for id, p := range r.pending {
+		if now.After(p.expires) {
+			delete(r.pending, id)
+		}
+	}
+	for peer, started := range r.started {
+		if len(started) == 0 || now.Sub(started[len(started)-1]) >= pairingRateWindow {
+			delete(r.started, peer)
+		}
+	}
+}
+
+// reload reads the registry file if it changed since it was last read or written
+func (r *Registry) reload() error {
+	fi, err := os.Stat(r.path)
+	if err != nil {
+		return err
+	}
+	if fi.ModTime().Equal(r.modTime) {
+		return nil
+	}
+
+	b, err := os.ReadFile(r.path)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(b, r); err != nil {
+		return err
+	}
+	r.modTime = fi.ModTime()
+
+	return nil
+}
+
+func (r *Registry) save() error {
+	if err := writeJSON(r.path, r); err != nil {
+		return err
+	}
+
+	if fi, err := os.Stat(r.path); err == nil {
+		r.modTime = fi.ModTime()
+	}
+
+	return nil
+}
+
+func hashToken(token string) string {
+	h := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(h[:])
+}
+
+func randomHex(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(b), nil
+}
+
+func writeJSON(path string, v interface{}) error {
+	b, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, b, 0600)
+}
@@ -0,0 +1,124 @@
+package pairing
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"testing"
+)
+
+func newTestRegistry(t *testing.T) *Registry {
+	t.Helper()
+
+	r, err := LoadRegistry(filepath.Join(t.TempDir(), "clients.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return r
+}
+
+func TestBeginRejectsTakenNames(t *testing.T) {
+	r := newTestRegistry(t)
+
+	id, code, err := r.Begin("laptop", "10.0.0.2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := r.Begin("laptop", "10.0.0.3"); !errors.Is(err, ErrNameTaken) {
+		t.Fatalf("Begin with a pending name = %v, want ErrNameTaken", err)
+	}
+
+	if _, err := r.Confirm(id, code); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := r.Begin("laptop", "10.0.0.3"); !errors.Is(err, ErrNameTaken) {
+		t.Fatalf("Begin with a paired name = %v, want ErrNameTaken", err)
+	}
+
+	if err := r.Revoke("laptop"); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := r.Begin("laptop", "10.0.0.3"); err != nil {
+		t.Fatalf("Begin with a revoked name = %v", err)
+	}
+}
+
+func TestBeginRateLimitsPeers(t *testing.T) {
+	r := newTestRegistry(t)
+
+	for i := 0; i < maxPairingsPerPeer; i++ {
+		if _, _, err := r.Begin(fmt.Sprintf("client-%d", i), "10.0.0.2"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, _, err := r.Begin("one-more", "10.0.0.2"); !errors.Is(err, ErrTooManyPairings) {
+		t.Fatalf("Begin over the peer limit = %v, want ErrTooManyPairings", err)
+	}
+	if _, _, err := r.Begin("one-more", "10.0.0.3"); err != nil {
+		t.Fatalf("Begin from another peer = %v", err)
+	}
+}
+
+func TestBeginCapsPending(t *testing.T) {
+	r := newTestRegistry(t)
+
+	for i := 0; i < maxPending; i++ {
+		if _, _, err := r.Begin(fmt.Sprintf("client-%d", i), fmt.Sprintf("10.0.0.%d", i)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, _, err := r.Begin("one-more", "10.0.1.1"); !errors.Is(err, ErrTooManyPairings) {
+		t.Fatalf("Begin over the pending limit = %v, want ErrTooManyPairings", err)
+	}
+}
+
+func TestBeginRejectsEmptyNames(t *testing.T) {
+	r := newTestRegistry(t)
+
+	for _, name := range []string{"", " ", "\t\n"} {
+		if _, _, err := r.Begin(name, "10.0.0.2"); !errors.Is(err, ErrInvalidName) {
+			t.Errorf("Begin(%q) = %v, want ErrInvalidName", name, err)
+		}
+	}
+}
+
+func TestConfirmKeepsRevocations(t *testing.T) {
+	r := newTestRegistry(t)
+
+	id, code, err := r.Begin("laptop", "10.0.0.2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.Confirm(id, code); err != nil {
+		t.Fatal(err)
+	}
+	id, code, err = r.Begin("phone", "10.0.0.3")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Revoked by the clients command, which runs in a process of its own
+	other, err := LoadRegistry(r.path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := other.Revoke("laptop"); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := r.Confirm(id, code); err != nil {
+		t.Fatal(err)
+	}
+	saved, err := LoadRegistry(r.path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, c := range saved.List() {
+		names = append(names, c.Name)
+	}
+	if len(names) != 1 || names[0] != "phone" {
+		t.Fatalf("clients = %v, want [phone]", names)
+	}
+}
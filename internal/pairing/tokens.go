@@ -0,0 +1,56 @@
+package pairing
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+)
+
+// TokenStore is the client side store of tokens issued by servers, keyed by server id.
+type TokenStore struct {
+	mu   sync.Mutex
+	path string
+
+	Tokens map[string]string `json:"tokens"`
+}
+
+// LoadTokenStore reads the token store at path. A missing file is an empty store.
+func LoadTokenStore(path string) (*TokenStore, error) {
+	s := &TokenStore{path: path, Tokens: make(map[string]string)}
+
+	b, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("pairing.LoadTokenStore: %w", err)
+	}
+
+	if err := json.Unmarshal(b, s); err != nil {
+		return nil, fmt.Errorf("pairing.LoadTokenStore: %w", err)
+	}
+	if s.Tokens == nil {
+		s.Tokens = make(map[string]string)
+	}
+
+	return s, nil
+}
+
+func (s *TokenStore) Get(serverID string) (string, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	token, ok := s.Tokens[serverID]
+	return token, ok
+}
+
+func (s *TokenStore) Set(serverID, token string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.Tokens[serverID] = token
+
+	return writeJSON(s.path, s)
+}
@@ -0,0 +1,127 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	"github.com/andree-bjorkgard/remote-bluetooth/internal/bluetooth"
+	"github.com/andree-bjorkgard/remote-bluetooth/internal/bluetooth/grpc"
+)
+
+// Adapter is the Bluetooth adapter of a server
+type Adapter struct {
+	Host    string
+	ID      string
+	Address string
+	Name    string
+	// Alias is the name other devices see
+	Alias        string
+	Powered      bool
+	Discoverable bool
+	// DiscoverableTimeout is how long the adapter stays discoverable, 0 means until it is turned off
+	DiscoverableTimeout time.Duration
+	Pairable            bool
+	Discovering         bool
+}
+
+// RequestOption changes a device or adapter request
+type RequestOption = bluetooth.RequestOption
+
+// OnAdapter makes the request use the adapter with the id, e.g. hci1, instead of the default adapter or
+// the first adapter that knows the device. Discovery uses every adapter unless it is given.
+func OnAdapter(id string) RequestOption {
+	return bluetooth.OnAdapter(id)
+}
+
+// WithPowerOn makes ConnectToDevice power on the adapter if it is off, instead of failing with ErrAdapterNotReady
+func WithPowerOn() RequestOption {
+	return bluetooth.WithPowerOn()
+}
+
+// OnTransport makes GetVolume and SetVolume use the media transport with the id, e.g. sep1/fd0, instead of
+// the first transport of the device with a volume
+func OnTransport(id string) RequestOption {
+	return bluetooth.OnTransport(id)
+}
+
+// ListAdapters returns every adapter of the server, the default adapter first
+func (c *Client) ListAdapters(ctx context.Context, server string) ([]Adapter, error) {
+	bc, ok := c.servers.client(server)
+	if !ok {
+		return nil, ErrServerNotFound
+	}
+
+	as, err := bc.ListAdapters(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	adapters := make([]Adapter, 0, len(as))
+	for _, a := range as {
+		adapters = append(adapters, grpcAdapterToClientAdapter(a, server))
+	}
+
+	return adapters, nil
+}
+
+func (c *Client) GetAdapter(ctx context.Context, server string, opts ...RequestOption) (Adapter, error) {
+	return c.onAdapter(server, func(bc *bluetooth.BluetoothClient) (*grpc.Adapter, error) {
+		return bc.GetAdapter(ctx, opts...)
+	})
+}
+
+func (c *Client) SetPowered(ctx context.Context, server string, powered bool, opts ...RequestOption) (Adapter, error) {
+	return c.onAdapter(server, func(bc *bluetooth.BluetoothClient) (*grpc.Adapter, error) {
+		return bc.SetPowered(ctx, powered, opts...)
+	})
+}
+
+// SetDiscoverable makes the server visible to other devices, until the timeout passes unless it is 0.
+// The timeout is rounded down to whole seconds.
+func (c *Client) SetDiscoverable(ctx context.Context, server string, discoverable bool, timeout time.Duration, opts ...RequestOption) (Adapter, error) {
+	return c.onAdapter(server, func(bc *bluetooth.BluetoothClient) (*grpc.Adapter, error) {
+		return bc.SetDiscoverable(ctx, discoverable, timeout, opts...)
+	})
+}
+
+func (c *Client) SetPairable(ctx context.Context, server string, pairable bool, opts ...RequestOption) (Adapter, error) {
+	return c.onAdapter(server, func(bc *bluetooth.BluetoothClient) (*grpc.Adapter, error) {
+		return bc.SetPairable(ctx, pairable, opts...)
+	})
+}
+
+// SetAlias sets the name other devices see the server as, an empty alias resets it to the system name
+func (c *Client) SetAlias(ctx context.Context, server, alias string, opts ...RequestOption) (Adapter, error) {
+	return c.onAdapter(server, func(bc *bluetooth.BluetoothClient) (*grpc.Adapter, error) {
+		return bc.SetAlias(ctx, alias, opts...)
+	})
+}
+
+func (c *Client) onAdapter(server string, fn func(bc *bluetooth.BluetoothClient) (*grpc.Adapter, error)) (Adapter, error) {
+	bc, ok := c.servers.client(server)
+	if !ok {
+		return Adapter{}, ErrServerNotFound
+	}
+
+	a, err := fn(bc)
+	if err != nil {
+		return Adapter{}, err
+	}
+
+	return grpcAdapterToClientAdapter(a, server), nil
+}
+
+func grpcAdapterToClientAdapter(a *grpc.Adapter, server string) Adapter {
+	return Adapter{
+		Host:                server,
+		ID:                  a.Id,
+		Address:             a.Address,
+		Name:                a.Name,
+		Alias:               a.Alias,
+		Powered:             a.Powered,
+		Discoverable:        a.Discoverable,
+		DiscoverableTimeout: time.Duration(a.DiscoverableTimeout) * time.Second,
+		Pairable:            a.Pairable,
+		Discovering:         a.Discovering,
+	}
+}
@@ -0,0 +1,119 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	"github.com/andree-bjorkgard/remote-bluetooth/internal/advertisement"
+	"github.com/andree-bjorkgard/remote-bluetooth/internal/bluetooth"
+	"github.com/andree-bjorkgard/remote-bluetooth/internal/bluetooth/grpc"
+)
+
+// AdvertisementFilter narrows the advertisements that are observed, the zero filter observes every LE device
+type AdvertisementFilter = bluetooth.ObserveFilter
+
+// The payloads the server decodes from advertisements
+type (
+	IBeacon        = advertisement.IBeacon
+	Eddystone      = advertisement.Eddystone
+	EddystoneFrame = advertisement.EddystoneFrame
+	BTHome         = advertisement.BTHome
+	Measurement    = advertisement.Measurement
+)
+
+const (
+	EddystoneUID = advertisement.EddystoneUID
+	EddystoneURL = advertisement.EddystoneURL
+	EddystoneTLM = advertisement.EddystoneTLM
+	EddystoneEID = advertisement.EddystoneEID
+)
+
+// Advertisement is what a device broadcasts, as received by a server
+type Advertisement struct {
+	Host      string
+	AdapterID string
+	Address   string
+	Name      string
+	RSSI      int16
+	UUIDs     []string
+	// ManufacturerData is keyed by company id
+	ManufacturerData map[uint16][]byte
+	// ServiceData is keyed by full service UUID
+	ServiceData map[string][]byte
+
+	// The decoded payloads, nil if the advertisement does not carry them
+	IBeacon   *IBeacon
+	Eddystone *Eddystone
+	BTHome    *BTHome
+}
+
+// ObserveAdvertisements makes the server observe the advertisements of the devices in range, without
+// connecting to them. Every advertisement that passes the filter is sent on the returned channel, which is
+// closed when ctx is done.
+func (c *Client) ObserveAdvertisements(ctx context.Context, server string, filter AdvertisementFilter, opts ...RequestOption) (<-chan Advertisement, error) {
+	bc, ok := c.servers.client(server)
+	if !ok {
+		return nil, ErrServerNotFound
+	}
+
+	ads, err := bc.ObserveAdvertisements(ctx, filter, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	ch := make(chan Advertisement, 20)
+	go func() {
+		defer close(ch)
+		for ad := range ads {
+			select {
+			case ch <- grpcAdvertisementToClientAdvertisement(ad, server):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch, nil
+}
+
+func grpcAdvertisementToClientAdvertisement(ad *grpc.Advertisement, server string) Advertisement {
+	a := Advertisement{
+		Host:             server,
+		AdapterID:        ad.AdapterId,
+		Address:          ad.Address,
+		Name:             ad.Name,
+		RSSI:             int16(ad.Rssi),
+		UUIDs:            ad.Uuids,
+		ManufacturerData: make(map[uint16][]byte, len(ad.ManufacturerData)),
+		ServiceData:      ad.ServiceData,
+	}
+	for id, data := range ad.ManufacturerData {
+		a.ManufacturerData[uint16(id)] = data
+	}
+
+	if b := ad.Ibeacon; b != nil {
+		a.IBeacon = &IBeacon{UUID: b.Uuid, Major: uint16(b.Major), Minor: uint16(b.Minor), TxPower: int8(b.TxPower)}
+	}
+	if e := ad.Eddystone; e != nil {
+		a.Eddystone = &Eddystone{
+			Frame:              EddystoneFrame(e.Frame),
+			TxPower:            int8(e.TxPower),
+			Namespace:          e.Namespace,
+			Instance:           e.Instance,
+			URL:                e.Url,
+			BatteryVoltage:     uint16(e.BatteryVoltage),
+			Temperature:        e.Temperature,
+			AdvertisementCount: e.AdvertisementCount,
+			Uptime:             time.Duration(e.Uptime) * time.Millisecond,
+			EID:                e.Eid,
+		}
+	}
+	if h := ad.Bthome; h != nil {
+		a.BTHome = &BTHome{Encrypted: h.Encrypted, TriggerBased: h.TriggerBased}
+		for _, m := range h.Measurements {
+			a.BTHome.Measurements = append(a.BTHome.Measurements, Measurement{Name: m.Name, Value: m.Value, Unit: m.Unit})
+		}
+	}
+
+	return a
+}
@@ -0,0 +1,106 @@
+package client
+
+import (
+	"context"
+
+	"github.com/andree-bjorkgard/remote-bluetooth/internal/bluetooth/grpc"
+)
+
+type PairingPromptType int
+
+const (
+	// The device needs a PIN, which the answer must contain
+	PromptPinCode PairingPromptType = iota
+	// The PIN must be entered on the device, no answer is needed
+	PromptDisplayPinCode
+	// The device needs a numeric passkey, which the answer must contain
+	PromptPasskey
+	// The passkey must be typed on the device, Entered counts the digits typed so far. No answer is needed
+	PromptDisplayPasskey
+	// The passkey shown by the device must be confirmed
+	PromptConfirmation
+	// The device wants to pair without any check
+	PromptAuthorization
+	// The device wants to use the service UUID
+	PromptAuthorizeService
+)
+
+func (t PairingPromptType) String() string {
+	switch t {
+	case PromptPinCode:
+		return "pin code"
+	case PromptDisplayPinCode:
+		return "display pin code"
+	case PromptPasskey:
+		return "passkey"
+	case PromptDisplayPasskey:
+		return "display passkey"
+	case PromptConfirmation:
+		return "confirmation"
+	case PromptAuthorization:
+		return "authorization"
+	case PromptAuthorizeService:
+		return "authorize service"
+	}
+
+	return "unknown"
+}
+
+// PairingPrompt is a question a server asks while pairing one of its devices
+type PairingPrompt struct {
+	Server  string
+	Type    PairingPromptType
+	Device  Device
+	PinCode string
+	Passkey uint32
+	Entered uint16
+	UUID    string
+}
+
+// PairingAnswer answers a PairingPrompt, PinCode or Passkey are only needed when the prompt asks for them
+type PairingAnswer struct {
+	Accept  bool
+	PinCode string
+	Passkey uint32
+}
+
+// PairingAgent answers a prompt. ctx is cancelled when the server withdraws the prompt,
+// the answer to a display prompt is ignored.
+type PairingAgent func(ctx context.Context, prompt PairingPrompt) PairingAnswer
+
+// RunPairingAgent answers the prompts the server shows while pairing devices until ctx is cancelled.
+// Prompts are handled concurrently.
+func (c *Client) RunPairingAgent(ctx context.Context, server string, agent PairingAgent) error {
+	bc, ok := c.servers.client(server)
+	if !ok {
+		return ErrServerNotFound
+	}
+
+	return bc.PairingAgent(ctx, func(ctx context.Context, req *grpc.AgentRequest) *grpc.AgentResponse {
+		prompt := PairingPrompt{
+			Server:  server,
+			Type:    promptTypes[req.Type],
+			PinCode: req.PinCode,
+			Passkey: req.Passkey,
+			Entered: uint16(req.Entered),
+			UUID:    req.Uuid,
+		}
+		if req.Device != nil {
+			prompt.Device = *grpcDeviceToClientDevice(req.Device, server)
+		}
+
+		a := agent(ctx, prompt)
+
+		return &grpc.AgentResponse{Accept: a.Accept, PinCode: a.PinCode, Passkey: a.Passkey}
+	})
+}
+
+var promptTypes = map[grpc.AgentRequest_Type]PairingPromptType{
+	grpc.AgentRequest_REQUEST_PIN_CODE:      PromptPinCode,
+	grpc.AgentRequest_DISPLAY_PIN_CODE:      PromptDisplayPinCode,
+	grpc.AgentRequest_REQUEST_PASSKEY:       PromptPasskey,
+	grpc.AgentRequest_DISPLAY_PASSKEY:       PromptDisplayPasskey,
+	grpc.AgentRequest_REQUEST_CONFIRMATION:  PromptConfirmation,
+	grpc.AgentRequest_REQUEST_AUTHORIZATION: PromptAuthorization,
+	grpc.AgentRequest_AUTHORIZE_SERVICE:     PromptAuthorizeService,
+}
@@ -0,0 +1,114 @@
+package client
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/andree-bjorkgard/remote-bluetooth/internal/bluetooth"
+	"github.com/andree-bjorkgard/remote-bluetooth/internal/bluetooth/grpc"
+)
+
+type BatterySample struct {
+	Time       time.Time
+	Percentage byte
+}
+
+type BatteryHistory struct {
+	Server  string
+	Address string
+	// Oldest first
+	Samples []BatterySample
+	// TimeToEmpty is the estimated time until the battery is empty, nil while it is not discharging
+	TimeToEmpty *time.Duration
+}
+
+// GetBatteryHistory returns the battery levels the server recorded for a device, or for all devices if address is empty
+func (c *Client) GetBatteryHistory(ctx context.Context, server, address string) ([]BatteryHistory, error) {
+	bc, ok := c.servers.client(server)
+	if !ok {
+		return nil, ErrServerNotFound
+	}
+
+	hs, err := bc.GetBatteryHistory(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+
+	histories := make([]BatteryHistory, 0, len(hs))
+	for _, h := range hs {
+		history := BatteryHistory{Server: server, Address: h.Address}
+		for _, s := range h.Samples {
+			history.Samples = append(history.Samples, BatterySample{Time: time.Unix(s.Timestamp, 0), Percentage: byte(s.Percentage)})
+		}
+		if h.TimeToEmpty != nil {
+			d := time.Duration(*h.TimeToEmpty) * time.Second
+			history.TimeToEmpty = &d
+		}
+		histories = append(histories, history)
+	}
+
+	return histories, nil
+}
+
+// LowBatteryEvent is sent when the battery of a device drops to or below one of the server's thresholds
+type LowBatteryEvent struct {
+	Server     string
+	Device     Device
+	Threshold  byte
+	Percentage byte
+	// TimeToEmpty is the estimated time until the battery is empty, nil if there is no estimate yet
+	TimeToEmpty *time.Duration
+}
+
+// OnLowBattery calls fn for every low battery alert of every server found by FindServers.
+// fn is called from a single goroutine per server and should not block for long.
+func (c *Client) OnLowBattery(fn func(LowBatteryEvent)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.lowBatteryHooks = append(c.lowBatteryHooks, fn)
+}
+
+// watchBatteryAlerts runs the low battery hooks for the alerts of a server until ctx is done. A stream that
+// ends is opened again, backing off like the device updates.
+func (c *Client) watchBatteryAlerts(ctx context.Context, addr string, bc *bluetooth.BluetoothClient) {
+	var delay time.Duration
+	for {
+		opened := time.Now()
+		ch, err := bc.WatchBatteryAlerts(ctx)
+		if err != nil {
+			log.Println("Error watching battery alerts: ", err)
+		} else {
+			for a := range ch {
+				c.runLowBatteryHooks(addr, a)
+			}
+		}
+
+		delay = c.nextRetryDelay(delay, time.Since(opened))
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+	}
+}
+
+func (c *Client) runLowBatteryHooks(addr string, a *grpc.BatteryAlert) {
+	e := LowBatteryEvent{Server: addr, Threshold: byte(a.Threshold), Percentage: byte(a.Percentage)}
+	if a.Device != nil {
+		e.Device = *grpcDeviceToClientDevice(a.Device, addr)
+	}
+	if a.TimeToEmpty != nil {
+		d := time.Duration(*a.TimeToEmpty) * time.Second
+		e.TimeToEmpty = &d
+	}
+
+	c.mu.Lock()
+	hooks := append(([]func(LowBatteryEvent))(nil), c.lowBatteryHooks...)
+	c.mu.Unlock()
+
+	for _, hook := range hooks {
+		hook(e)
+	}
+}
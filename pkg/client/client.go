@@ -1,10 +1,15 @@
 package client
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"log"
-	"net"
-	"strings"
+	"os"
+	"sync"
+	"time"
+
+	ggrpc "google.golang.org/grpc"
 
 	"github.com/andree-bjorkgard/remote-bluetooth/internal/bluetooth"
 	"github.com/andree-bjorkgard/remote-bluetooth/internal/bluetooth/grpc"
@@ -12,19 +17,101 @@ import (
 	"github.com/andree-bjorkgard/remote-bluetooth/pkg/config"
 )
 
+const (
+	rediscoverInterval = time.Minute
+	serverLostAfter    = 2 * time.Minute
+	streamRetryDelay   = time.Second
+	streamMaxRetry     = 30 * time.Second
+)
+
 var (
 	ErrServerNotFound = errors.New("server not found")
+
+	// Errors returned by servers, match them with errors.Is
+	ErrDeviceNotFound    = bluetooth.ErrDeviceNotFound
+	ErrDeviceUnavailable = bluetooth.ErrDeviceUnavailable
+	ErrAdapterNotFound   = bluetooth.ErrAdapterNotFound
+	// ErrBluetoothUnavailable also matches ErrDeviceUnavailable
+	ErrBluetoothUnavailable = bluetooth.ErrBluetoothUnavailable
+	ErrAdapterNotReady      = bluetooth.ErrAdapterNotReady
+	ErrNotConnected         = bluetooth.ErrNotConnected
+	ErrAlreadyConnected     = bluetooth.ErrAlreadyConnected
+	ErrInProgress           = bluetooth.ErrInProgress
+	ErrTimeout              = bluetooth.ErrTimeout
+	ErrUnauthenticated      = bluetooth.ErrUnauthenticated
+	ErrPermissionDenied     = bluetooth.ErrPermissionDenied
+	ErrConnectingFailed     = bluetooth.ErrConnectingFailed
+	ErrPairingFailed        = bluetooth.ErrPairingFailed
+	ErrUnknownProfile       = bluetooth.ErrUnknownProfile
+	ErrProfileNotSupported  = bluetooth.ErrProfileNotSupported
+	// ErrNoMediaPlayer is returned by the media controls unless AVRCP is connected
+	ErrNoMediaPlayer          = bluetooth.ErrNoMediaPlayer
+	ErrMediaTransportNotFound = bluetooth.ErrMediaTransportNotFound
+	ErrVolumeNotSupported     = bluetooth.ErrVolumeNotSupported
+	ErrInvalidVolume          = bluetooth.ErrInvalidVolume
+	ErrCharacteristicNotFound = bluetooth.ErrCharacteristicNotFound
+	// ErrNotPermitted is returned when the characteristic does not allow the read, write or notify
+	ErrNotPermitted = bluetooth.ErrNotPermitted
 )
 
 type Device struct {
 	Name          string
+	Alias         string
 	Host          string
 	Address       string
+	AdapterID     string
 	Trusted       bool
 	Paired        bool
 	Connected     bool
-	BatteryStatus string
+	Blocked       bool
+	LegacyPairing bool
 	Icon          string
+	Class         uint32
+	Appearance    uint16
+
+	// RSSI and TxPower are nil when the device is not in range
+	RSSI    *int16
+	TxPower *int16
+
+	UUIDs    []string
+	Profiles []Profile
+	// ConnectedProfiles are the profiles connected right now
+	ConnectedProfiles []Profile
+
+	// Modalias is nil if the device does not report one
+	Modalias *Modalias
+
+	// Battery is nil when the battery level is unknown
+	Battery *Battery
+	// Deprecated: BatteryStatus is the battery percentage as a string, use Battery instead
+	BatteryStatus string
+}
+
+type Battery struct {
+	Percentage byte
+	// Source is where the level comes from, e.g. the BlueZ interface "org.bluez.Battery1"
+	Source string
+}
+
+// Profile is a service of a device, Name is the UUID if the service has no assigned name
+type Profile struct {
+	UUID string
+	Name string
+}
+
+// Modalias identifies the vendor and model of a device. Source is "usb" or "bluetooth",
+// depending on who assigned the vendor ID.
+type Modalias struct {
+	Source  string
+	Vendor  uint16
+	Product uint16
+	Version uint16
+}
+
+// DiscoveredDevice is a device seen by a scan, RSSI is its signal strength in dBm
+type DiscoveredDevice struct {
+	Device
+	RSSI int16
 }
 
 type DeviceEvent struct {
@@ -33,141 +120,486 @@ type DeviceEvent struct {
 }
 
 type Client struct {
-	cfg         config.Config
-	connections map[string]*bluetooth.BluetoothClient
-	channel     chan DeviceEvent
+	cfg           config.Config
+	servers       *serverRegistry
+	channel       chan DeviceEvent
+	serverChannel chan ServerEvent
+
+	ctx       context.Context
+	cancel    context.CancelFunc
+	wg        sync.WaitGroup
+	mu        sync.Mutex
+	closed    bool
+	closeOnce sync.Once
+
+	lowBatteryHooks []func(LowBatteryEvent)
+
+	// discover broadcasts for servers once and sends the addresses that answer until ctx is done
+	discover func(ctx context.Context) (<-chan string, error)
+	// dialOpts are appended to the dial options of every server
+	dialOpts []ggrpc.DialOption
+	// rediscoverInterval is how often discovery is broadcast again. A server is dropped once it stayed
+	// unreachable for lostAfter. Streams are reopened after retryDelay, doubling up to maxRetryDelay.
+	rediscoverInterval time.Duration
+	lostAfter          time.Duration
+	retryDelay         time.Duration
+	maxRetryDelay      time.Duration
+	// handoffConfirmTimeout bounds the wait for the disconnect of a handoff, handoffRetryDelay is the delay
+	// between its connect attempts
+	handoffConfirmTimeout time.Duration
+	handoffRetryDelay     time.Duration
 }
 
 func NewClient(cfg config.Config) *Client {
-	ch := make(chan DeviceEvent, 20)
+	ctx, cancel := context.WithCancel(context.Background())
+	discoveryService := discovery.NewDiscoveryService(cfg.BroadcastPort, cfg.BroadcastMessage, cfg.BroadcastServerResponse)
+
+	return &Client{
+		cfg:           cfg,
+		servers:       newServerRegistry(),
+		channel:       make(chan DeviceEvent, 20),
+		serverChannel: make(chan ServerEvent, 20),
+		ctx:           ctx,
+		cancel:        cancel,
+
+		discover:           discoveryService.DiscoverRemote,
+		rediscoverInterval: rediscoverInterval,
+		lostAfter:          serverLostAfter,
+		retryDelay:         streamRetryDelay,
+		maxRetryDelay:      streamMaxRetry,
+
+		handoffConfirmTimeout: handoffConfirmTimeout,
+		handoffRetryDelay:     handoffRetryDelay,
+	}
+}
+
+// FindServers discovers servers and reports their devices on the events channel until ctx is cancelled
+// or the client is closed. Discovery is broadcast again every minute, so servers that were lost or started
+// later are found too. It fails if discovery cannot start, e.g. without a network interface to broadcast on.
+func (c *Client) FindServers(ctx context.Context) error {
+	if !c.track() {
+		return nil
+	}
+	defer c.wg.Done()
 
-	return &Client{cfg: cfg, channel: ch, connections: make(map[string]*bluetooth.BluetoothClient)}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stop := context.AfterFunc(c.ctx, cancel)
+	defer stop()
+
+	for first := true; ; first = false {
+		round, cancelRound := context.WithTimeout(ctx, c.rediscoverInterval)
+		ch, err := c.discover(round)
+		if err != nil {
+			if first {
+				cancelRound()
+				return fmt.Errorf("Client.FindServers: %w", err)
+			}
+			log.Println("Error rediscovering servers: ", err)
+		} else {
+			for addr := range ch {
+				if !c.servers.has(addr) {
+					c.addServer(ctx, addr)
+				}
+			}
+		}
+
+		// The next round starts once this one is over
+		<-round.Done()
+		cancelRound()
+		if ctx.Err() != nil {
+			return nil
+		}
+	}
 }
 
-func (c *Client) FindServers() {
-	ifs, err := net.Interfaces()
+func (c *Client) addServer(ctx context.Context, addr string) {
+	bc, err := bluetooth.NewBluetoothClient(addr, c.cfg, c.dialOpts...)
 	if err != nil {
-		panic(err)
+		log.Println("Error creating client: ", err)
+		return
 	}
 
-	discoveryService := discovery.NewDiscoveryService(c.cfg.BroadcastPort, c.cfg.BroadcastMessage, c.cfg.BroadcastServerResponse)
+	server := Server{Address: addr}
+	if info, err := bc.ServerInfo(ctx); err != nil {
+		log.Println("Error getting server info: ", err)
+	} else {
+		server.ID, server.Name = info.Id, info.Name
+	}
+
+	if err := bc.UsePairedToken(ctx); err != nil {
+		log.Println("Error looking up token: ", err)
+	}
 
+	ds, err := bc.GetTrustedDevices(ctx)
 	if err != nil {
-		panic(err)
+		log.Println("Error getting trusted devices: ", err)
+		bc.Close()
+		return
 	}
 
-	var ignoreList []net.IP
-	var broadcastIPs []net.IP
-	for _, i := range ifs {
-		addr, err := i.Addrs()
-		if err != nil {
-			panic(err)
-		}
-		for _, a := range addr {
-			ipAddr, ok := a.(*net.IPNet)
-			// Ignore loopback and ipv6 addresses
-			if ok && !ipAddr.IP.IsLoopback() && strings.Count(ipAddr.IP.String(), ":") < 2 {
-				ignoreList = append(ignoreList, ipAddr.IP)
-				broadcastIPs = append(broadcastIPs, subnetBroadcastIP(*ipAddr))
-			}
-		}
+	if !c.track() {
+		bc.Close()
+		return
+	}
+
+	// The watchers run until the server is dropped
+	ctx, cancel := context.WithCancel(ctx)
+	c.servers.add(server, bc, cancel)
+	c.sendServerEvent(ServerEvent{Type: ServerAdded, Server: server})
+	for _, d := range ds {
+		c.sendDeviceEvent(addr, grpcDeviceToClientDevice(d, addr))
 	}
 
-	ch := discoveryService.Discover(broadcastIPs)
+	go func() {
+		defer c.wg.Done()
+		c.watchDevices(ctx, addr, bc)
+	}()
 
-main:
+	if !c.track() {
+		return
+	}
+	go func() {
+		defer c.wg.Done()
+		c.watchBatteryAlerts(ctx, addr, bc)
+	}()
+}
+
+// watchDevices forwards live device updates from a server onto the events channel. A stream that ends, e.g.
+// on a network blip or a server restart, is opened again once the server answers. The server is dropped
+// only after it stayed unreachable for lostAfter, discovery adds it again once it is back.
+func (c *Client) watchDevices(ctx context.Context, addr string, bc *bluetooth.BluetoothClient) {
+	var delay time.Duration
 	for {
-		addr := <-ch
-		addrSplit := strings.Split(addr, ":")
-		if len(addrSplit) != 2 {
-			log.Println("Invalid address: ", addr)
+		opened := time.Now()
+		ch, err := bc.WatchDevices(ctx)
+		if err != nil {
+			log.Println("Error watching devices: ", err)
+		} else {
+			for d := range ch {
+				c.sendDeviceEvent(addr, grpcDeviceToClientDevice(d, addr))
+			}
+		}
+		if ctx.Err() != nil {
+			return
+		}
+
+		delay = c.nextRetryDelay(delay, time.Since(opened))
+		if c.awaitServer(ctx, addr, bc, delay) {
 			continue
 		}
+		if ctx.Err() != nil {
+			return
+		}
 
-		for _, ip := range ignoreList {
-			if ip.String() == addrSplit[0] {
-				log.Println("Ignoring local address: ", addr)
-				continue main
-			}
+		if server, ok := c.servers.remove(addr); ok {
+			c.sendServerEvent(ServerEvent{Type: ServerLost, Server: server})
 		}
+		return
+	}
+}
 
-		bc, err := bluetooth.NewBluetoothClient(addr, c.cfg.AuthenticationSecret)
-		if err != nil {
-			log.Println("Error creating client: ", err)
-			continue
+// awaitServer polls the server, starting after delay and backing off, until it answers. Its devices are sent
+// again, so the changes missed meanwhile are not lost. It returns false once the server stayed unreachable
+// for lostAfter or ctx is done.
+func (c *Client) awaitServer(ctx context.Context, addr string, bc *bluetooth.BluetoothClient, delay time.Duration) bool {
+	lost := time.Now().Add(c.lostAfter)
+	for {
+		select {
+		case <-ctx.Done():
+			return false
+		case <-time.After(delay):
 		}
 
-		c.connections[addr] = bc
-		ds, err := bc.GetTrustedDevices()
-		if err != nil {
-			log.Println("Error getting trusted devices: ", err)
-			continue
+		ds, err := bc.GetTrustedDevices(ctx)
+		if err == nil {
+			for _, d := range ds {
+				c.sendDeviceEvent(addr, grpcDeviceToClientDevice(d, addr))
+			}
+			return true
 		}
-		for _, d := range ds {
-			c.channel <- DeviceEvent{Server: addr, Device: grpcDeviceToClientDevice(d, addr)}
+		if time.Now().After(lost) {
+			log.Println("Server unreachable: ", addr, err)
+			return false
 		}
+		delay = min(2*delay, c.maxRetryDelay)
+	}
+}
+
+// nextRetryDelay is the delay before reopening a stream that ran for lasted. It doubles while the stream keeps
+// ending quickly, e.g. when the server refuses it, and starts over after a stream that ran for a while.
+func (c *Client) nextRetryDelay(delay, lasted time.Duration) time.Duration {
+	if lasted > c.maxRetryDelay {
+		return c.retryDelay
+	}
+
+	return min(max(2*delay, c.retryDelay), c.maxRetryDelay)
+}
+
+func (c *Client) sendDeviceEvent(addr string, d *Device) {
+	c.servers.setDevice(addr, d)
+
+	select {
+	case c.channel <- DeviceEvent{Server: addr, Device: d}:
+	case <-c.ctx.Done():
+	}
+}
+
+func (c *Client) sendServerEvent(e ServerEvent) {
+	select {
+	case c.serverChannel <- e:
+	case <-c.ctx.Done():
+	}
+}
+
+// track registers a goroutine with the client, it returns false once the client is closed
+func (c *Client) track() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.closed {
+		return false
 	}
+	c.wg.Add(1)
+
+	return true
+}
+
+// Close stops discovery and all watchers, closes the connections to every server and then the event channels.
+func (c *Client) Close() error {
+	var err error
+	c.closeOnce.Do(func() {
+		c.mu.Lock()
+		c.closed = true
+		c.mu.Unlock()
+
+		c.cancel()
+		c.wg.Wait()
+
+		err = c.servers.closeAll()
+		close(c.channel)
+		close(c.serverChannel)
+	})
+
+	return err
+}
+
+// Servers returns the servers currently known to the client
+func (c *Client) Servers() []Server {
+	return c.servers.list()
 }
 
-func (c *Client) ConnectToDevice(server, address string) error {
-	bc, ok := c.connections[server]
+func (c *Client) Server(addr string) (Server, bool) {
+	return c.servers.server(addr)
+}
+
+// Devices returns the last known state of the devices on all known servers
+func (c *Client) Devices() []Device {
+	return c.servers.devices()
+}
+
+func (c *Client) ConnectToDevice(ctx context.Context, server, address string, opts ...RequestOption) error {
+	bc, ok := c.servers.client(server)
 	if !ok {
 		return ErrServerNotFound
 	}
 
-	return bc.ConnectToDevice(address)
+	return bc.ConnectToDevice(ctx, address, opts...)
 }
 
-func (c *Client) DisconnectFromDevice(server, address string) error {
-	bc, ok := c.connections[server]
+func (c *Client) DisconnectFromDevice(ctx context.Context, server, address string, opts ...RequestOption) error {
+	bc, ok := c.servers.client(server)
 	if !ok {
 		return ErrServerNotFound
 	}
 
-	return bc.DisconnectFromDevice(address)
+	return bc.DisconnectFromDevice(ctx, address, opts...)
 }
 
+// ConnectProfile connects a single profile of the device, given as a UUID, a name like "Audio Sink" or a
+// short name: a2dp, avrcp, hfp, hsp, hid, pan or spp
+func (c *Client) ConnectProfile(ctx context.Context, server, address, profile string, opts ...RequestOption) error {
+	bc, ok := c.servers.client(server)
+	if !ok {
+		return ErrServerNotFound
+	}
+
+	return bc.ConnectProfile(ctx, address, profile, opts...)
+}
+
+// DisconnectProfile disconnects a single profile of the device and keeps the others connected
+func (c *Client) DisconnectProfile(ctx context.Context, server, address, profile string, opts ...RequestOption) error {
+	bc, ok := c.servers.client(server)
+	if !ok {
+		return ErrServerNotFound
+	}
+
+	return bc.DisconnectProfile(ctx, address, profile, opts...)
+}
+
+// StartDiscovery makes the server scan for devices. Every device it sees is sent on the returned channel,
+// which is closed when the scan ends, either by cancelling ctx or by calling StopDiscovery.
+func (c *Client) StartDiscovery(ctx context.Context, server string, opts ...RequestOption) (<-chan DiscoveredDevice, error) {
+	bc, ok := c.servers.client(server)
+	if !ok {
+		return nil, ErrServerNotFound
+	}
+
+	found, err := bc.StartDiscovery(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	ch := make(chan DiscoveredDevice, 10)
+	go func() {
+		defer close(ch)
+		for d := range found {
+			select {
+			case ch <- DiscoveredDevice{Device: *grpcDeviceToClientDevice(d.Device, server), RSSI: int16(d.Rssi)}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch, nil
+}
+
+// StopDiscovery stops the scan on the server, ending the discovery of every client scanning it
+func (c *Client) StopDiscovery(ctx context.Context, server string, opts ...RequestOption) error {
+	bc, ok := c.servers.client(server)
+	if !ok {
+		return ErrServerNotFound
+	}
+
+	return bc.StopDiscovery(ctx, opts...)
+}
+
+// PairDevice pairs the server with a device, usually one found with StartDiscovery.
+// The device also has to be trusted before the server reports it. Devices that need a PIN or a
+// confirmation only pair while RunPairingAgent is answering prompts for the server.
+func (c *Client) PairDevice(ctx context.Context, server, address string, opts ...RequestOption) error {
+	bc, ok := c.servers.client(server)
+	if !ok {
+		return ErrServerNotFound
+	}
+
+	return bc.PairDevice(ctx, address, opts...)
+}
+
+func (c *Client) TrustDevice(ctx context.Context, server, address string, opts ...RequestOption) error {
+	bc, ok := c.servers.client(server)
+	if !ok {
+		return ErrServerNotFound
+	}
+
+	return bc.TrustDevice(ctx, address, opts...)
+}
+
+func (c *Client) UntrustDevice(ctx context.Context, server, address string, opts ...RequestOption) error {
+	bc, ok := c.servers.client(server)
+	if !ok {
+		return ErrServerNotFound
+	}
+
+	return bc.UntrustDevice(ctx, address, opts...)
+}
+
+// RemoveDevice makes the server forget the device, including its pairing
+func (c *Client) RemoveDevice(ctx context.Context, server, address string, opts ...RequestOption) error {
+	bc, ok := c.servers.client(server)
+	if !ok {
+		return ErrServerNotFound
+	}
+
+	return bc.RemoveDevice(ctx, address, opts...)
+}
+
+// PairServer pairs this machine with a server. The server shows a 6-digit code, which confirm must return
+// once the user has entered it. From then on the server accepts this client without the shared secret.
+func (c *Client) PairServer(ctx context.Context, server string, confirm func() (string, error)) error {
+	bc, ok := c.servers.client(server)
+	if !ok {
+		return ErrServerNotFound
+	}
+
+	name, err := os.Hostname()
+	if err != nil {
+		return err
+	}
+
+	return bc.Pair(ctx, name, confirm)
+}
+
+// GetDeviceEventsChannel returns the device events channel, which is closed by Close
 func (c *Client) GetDeviceEventsChannel() <-chan DeviceEvent {
 	return c.channel
 }
 
+// GetServerEventsChannel returns the server events channel, which is closed by Close
+func (c *Client) GetServerEventsChannel() <-chan ServerEvent {
+	return c.serverChannel
+}
+
 func grpcDeviceToClientDevice(d *grpc.Device, host string) *Device {
-	return &Device{
+	dev := &Device{
 		Name:          d.Name,
+		Alias:         d.Alias,
 		Host:          host,
 		Address:       d.Address,
+		AdapterID:     d.AdapterId,
 		Trusted:       d.Trusted,
 		Paired:        d.Paired,
 		Connected:     d.Connected,
+		Blocked:       d.Blocked,
+		LegacyPairing: d.LegacyPairing,
 		BatteryStatus: d.BatteryStatus,
 		Icon:          d.Icon,
+		Class:         d.Class,
+		Appearance:    uint16(d.Appearance),
+		UUIDs:         d.Uuids,
 	}
-}
 
-func subnetBroadcastIP(ipnet net.IPNet) net.IP {
-	byteIp := []byte(ipnet.IP)
-	byteMask := []byte(ipnet.Mask)
-	// Using bytemask for length instead because IP is padded with zeros
-	byteBroadCastIP := make([]byte, len(byteMask))
-	byteIp = byteIp[len(byteIp)-len(byteMask):]
-
-	for k := range byteIp {
-		// mask will give us all fixed bits of the subnet (for the given byte)
-		// inverted mask will give us all moving bits of the subnet (for the given byte)
-		invertedMask := byteMask[k] ^ 0xff // inverted mask byte
-		// broadcastIP = networkIP added to the inverted mask
-		byteBroadCastIP[k] = byteIp[k]&byteMask[k] | invertedMask
+	if d.Rssi != nil {
+		v := int16(*d.Rssi)
+		dev.RSSI = &v
+	}
+	if d.TxPower != nil {
+		v := int16(*d.TxPower)
+		dev.TxPower = &v
+	}
+	for _, p := range d.Profiles {
+		dev.Profiles = append(dev.Profiles, Profile{UUID: p.Uuid, Name: p.Name})
+	}
+	for _, p := range d.ConnectedProfiles {
+		dev.ConnectedProfiles = append(dev.ConnectedProfiles, Profile{UUID: p.Uuid, Name: p.Name})
+	}
+	if d.Battery != nil {
+		dev.Battery = &Battery{Percentage: byte(d.Battery.Percentage), Source: d.Battery.Source}
+	}
+	if d.Modalias != nil {
+		dev.Modalias = &Modalias{
+			Source:  d.Modalias.Source,
+			Vendor:  uint16(d.Modalias.Vendor),
+			Product: uint16(d.Modalias.Product),
+			Version: uint16(d.Modalias.Version),
+		}
 	}
 
-	return net.IP(byteBroadCastIP)
+	return dev
 }
 
 func (d *Device) GetName() (string, error) {
 	return d.Name, nil
 }
 
+// GetAlias falls back to the name for servers that do not send the alias
 func (d *Device) GetAlias() (string, error) {
-	return d.Name, nil
+	if d.Alias == "" {
+		return d.Name, nil
+	}
+
+	return d.Alias, nil
 }
 
 func (d *Device) GetAddress() (string, error) {
@@ -190,6 +622,10 @@ func (d *Device) GetConnected() (bool, error) {
 	return d.Connected, nil
 }
 
+func (d *Device) GetBattery() (*Battery, error) {
+	return d.Battery, nil
+}
+
 func (d *Device) GetBatteryStatus() (string, error) {
 	return d.BatteryStatus, nil
 }
@@ -197,3 +633,31 @@ func (d *Device) GetBatteryStatus() (string, error) {
 func (d *Device) GetIcon() (string, error) {
 	return d.Icon, nil
 }
+
+func (d *Device) GetAdapterID() (string, error) {
+	return d.AdapterID, nil
+}
+
+func (d *Device) GetBlocked() (bool, error) {
+	return d.Blocked, nil
+}
+
+func (d *Device) GetLegacyPairing() (bool, error) {
+	return d.LegacyPairing, nil
+}
+
+func (d *Device) GetClass() (uint32, error) {
+	return d.Class, nil
+}
+
+func (d *Device) GetAppearance() (uint16, error) {
+	return d.Appearance, nil
+}
+
+func (d *Device) GetUUIDs() ([]string, error) {
+	return d.UUIDs, nil
+}
+
+func (d *Device) GetConnectedProfiles() ([]Profile, error) {
+	return d.ConnectedProfiles, nil
+}
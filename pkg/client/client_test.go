@@ -0,0 +1,250 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/backoff"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/andree-bjorkgard/remote-bluetooth/internal/bluetooth"
+	"github.com/andree-bjorkgard/remote-bluetooth/internal/bluetooth/fake"
+	"github.com/andree-bjorkgard/remote-bluetooth/pkg/config"
+)
+
+const testSecret = "s3cret"
+
+// testServer serves a fake adapter on in-memory listeners. Killing it drops every connection like a crashed
+// server, start serves again. The configuration is set up for the server and the test client.
+type testServer struct {
+	adapter *fake.Adapter
+
+	mu    sync.Mutex
+	lis   *bufconn.Listener
+	conns []net.Conn
+}
+
+func newTestServer(t *testing.T, adapter *fake.Adapter) *testServer {
+	t.Helper()
+
+	dir := t.TempDir()
+	t.Setenv("REMOTE_BLUETOOTH_SECRET", testSecret)
+	t.Setenv("REMOTE_BLUETOOTH_CLIENTS_FILE", filepath.Join(dir, "clients.json"))
+	t.Setenv("REMOTE_BLUETOOTH_TOKENS_FILE", filepath.Join(dir, "tokens.json"))
+	t.Setenv("REMOTE_BLUETOOTH_BATTERY_SAMPLE_INTERVAL", "0")
+	t.Setenv("REMOTE_BLUETOOTH_ACL_FILE", "")
+
+	s := &testServer{adapter: adapter}
+	s.start()
+	t.Cleanup(s.kill)
+
+	return s
+}
+
+func (s *testServer) start() {
+	lis := bufconn.Listen(1 << 20)
+	s.mu.Lock()
+	s.lis = lis
+	s.mu.Unlock()
+
+	srv := bluetooth.NewBluetoothServerWithAdapterSource(0, fake.NewAdapters(s.adapter), "")
+	go srv.Serve(lis)
+}
+
+func (s *testServer) kill() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.lis != nil {
+		s.lis.Close()
+		s.lis = nil
+	}
+	for _, conn := range s.conns {
+		conn.Close()
+	}
+	s.conns = nil
+}
+
+func (s *testServer) dial(ctx context.Context) (net.Conn, error) {
+	s.mu.Lock()
+	lis := s.lis
+	s.mu.Unlock()
+	if lis == nil {
+		return nil, errors.New("server is down")
+	}
+
+	conn, err := lis.DialContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	s.conns = append(s.conns, conn)
+	s.mu.Unlock()
+
+	return conn, nil
+}
+
+// testClient returns a client that discovers the servers by name, their address is "passthrough:///<name>".
+// Servers are dropped after being unreachable for lostAfter.
+func testClient(t *testing.T, servers map[string]*testServer, lostAfter time.Duration) *Client {
+	t.Helper()
+
+	c := NewClient(config.NewConfig())
+	c.discover = func(ctx context.Context) (<-chan string, error) {
+		ch := make(chan string, len(servers))
+		for name := range servers {
+			ch <- "passthrough:///" + name
+		}
+		go func() {
+			<-ctx.Done()
+			close(ch)
+		}()
+		return ch, nil
+	}
+	c.dialOpts = []grpc.DialOption{
+		grpc.WithContextDialer(func(ctx context.Context, name string) (net.Conn, error) {
+			s, ok := servers[name]
+			if !ok {
+				return nil, errors.New("unknown server " + name)
+			}
+			return s.dial(ctx)
+		}),
+		grpc.WithConnectParams(grpc.ConnectParams{
+			Backoff:           backoff.Config{BaseDelay: 10 * time.Millisecond, Multiplier: 1.6, MaxDelay: 50 * time.Millisecond},
+			MinConnectTimeout: time.Second,
+		}),
+	}
+	c.rediscoverInterval = 50 * time.Millisecond
+	c.lostAfter = lostAfter
+	c.retryDelay = 10 * time.Millisecond
+	c.maxRetryDelay = 50 * time.Millisecond
+
+	t.Cleanup(func() { c.Close() })
+	go func() {
+		if err := c.FindServers(context.Background()); err != nil {
+			t.Error(err)
+		}
+	}()
+
+	return c
+}
+
+// waitForServerEvent fails unless an event of the type is sent before the timeout, device events are dropped
+// meanwhile so that the client does not block on them
+func waitForServerEvent(t *testing.T, c *Client, typ ServerEventType) ServerEvent {
+	t.Helper()
+
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case e := <-c.GetServerEventsChannel():
+			if e.Type == typ {
+				return e
+			}
+			t.Fatalf("server event %d, want %d", e.Type, typ)
+		case <-c.GetDeviceEventsChannel():
+		case <-timeout:
+			t.Fatalf("no server event %d", typ)
+		}
+	}
+}
+
+// waitForDevice fails unless a device event passes match before the timeout, server events fail the test
+func waitForDevice(t *testing.T, c *Client, match func(DeviceEvent) bool) {
+	t.Helper()
+
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case e := <-c.GetDeviceEventsChannel():
+			if match(e) {
+				return
+			}
+		case e := <-c.GetServerEventsChannel():
+			t.Fatalf("unexpected server event %d for %s", e.Type, e.Server.Address)
+		case <-timeout:
+			t.Fatal("no matching device event")
+		}
+	}
+}
+
+func newTestDevices() (*fake.Device, *fake.Device) {
+	headphones := fake.NewDevice(fake.Properties{Address: "AA:AA:AA:AA:AA:01", Name: "Headphones", Trusted: true, Paired: true, Connected: true})
+	speaker := fake.NewDevice(fake.Properties{Address: "AA:AA:AA:AA:AA:02", Name: "Speaker", Trusted: true, Paired: true})
+
+	return headphones, speaker
+}
+
+func TestServerSurvivesRestart(t *testing.T) {
+	headphones, speaker := newTestDevices()
+	srv := newTestServer(t, fake.NewAdapter(headphones, speaker))
+	c := testClient(t, map[string]*testServer{"a": srv}, time.Minute)
+	ctx := context.Background()
+
+	added := waitForServerEvent(t, c, ServerAdded)
+
+	srv.kill()
+	speaker.Update(func(p *fake.Properties) { p.Connected = true })
+	time.Sleep(100 * time.Millisecond)
+	srv.start()
+
+	// The devices are sent again, so the change made while the server was down is not missed
+	waitForDevice(t, c, func(e DeviceEvent) bool {
+		return e.Device.Address == speaker.Properties().Address && e.Device.Connected
+	})
+
+	if err := c.DisconnectFromDevice(ctx, added.Server.Address, headphones.Properties().Address); err != nil {
+		t.Fatal(err)
+	}
+
+	// Updates flow through the reopened stream. It is opened in the background, so the device keeps changing
+	// until the server sends an update.
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		ticker := time.NewTicker(20 * time.Millisecond)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				headphones.Update(func(p *fake.Properties) { p.Alias = "Desk" })
+			}
+		}
+	}()
+	waitForDevice(t, c, func(e DeviceEvent) bool {
+		return e.Device.Address == headphones.Properties().Address && e.Device.Alias == "Desk"
+	})
+}
+
+func TestServerLostAndFoundAgain(t *testing.T) {
+	headphones, speaker := newTestDevices()
+	srv := newTestServer(t, fake.NewAdapter(headphones, speaker))
+	c := testClient(t, map[string]*testServer{"a": srv}, 200*time.Millisecond)
+	ctx := context.Background()
+
+	added := waitForServerEvent(t, c, ServerAdded)
+
+	srv.kill()
+	lost := waitForServerEvent(t, c, ServerLost)
+	if lost.Server.Address != added.Server.Address {
+		t.Fatalf("lost %s, want %s", lost.Server.Address, added.Server.Address)
+	}
+	if err := c.ConnectToDevice(ctx, added.Server.Address, speaker.Properties().Address); !errors.Is(err, ErrServerNotFound) {
+		t.Fatalf("ConnectToDevice on a lost server = %v, want ErrServerNotFound", err)
+	}
+
+	// Rediscovery adds the server again once it is back
+	srv.start()
+	waitForServerEvent(t, c, ServerAdded)
+	if err := c.ConnectToDevice(ctx, added.Server.Address, speaker.Properties().Address); err != nil {
+		t.Fatal(err)
+	}
+}
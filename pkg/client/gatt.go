@@ -0,0 +1,129 @@
+package client
+
+import (
+	"context"
+
+	"github.com/andree-bjorkgard/remote-bluetooth/internal/bluetooth"
+	"github.com/andree-bjorkgard/remote-bluetooth/internal/bluetooth/grpc"
+)
+
+// GattService is a GATT service of a connected device
+type GattService struct {
+	// ID identifies the service on the device, e.g. service000a. It can change when the device reconnects.
+	ID              string
+	Profile         Profile
+	Primary         bool
+	Characteristics []GattCharacteristic
+}
+
+type GattCharacteristic struct {
+	// ID identifies the characteristic on the device, e.g. service000a/char000b
+	ID   string
+	UUID string
+	// Flags are what the characteristic allows, e.g. read, write, write-without-response and notify
+	Flags       []string
+	Descriptors []GattDescriptor
+}
+
+type GattDescriptor struct {
+	ID    string
+	UUID  string
+	Flags []string
+}
+
+// CharacteristicValue is a value a device notified
+type CharacteristicValue struct {
+	Host             string
+	Address          string
+	CharacteristicID string
+	Value            []byte
+}
+
+// WithoutResponse makes WriteCharacteristic write without waiting for the device to confirm the write
+func WithoutResponse() RequestOption {
+	return bluetooth.WithoutResponse()
+}
+
+// ListGattServices returns the GATT services of the connected device with their characteristics
+func (c *Client) ListGattServices(ctx context.Context, server, address string, opts ...RequestOption) ([]GattService, error) {
+	bc, ok := c.servers.client(server)
+	if !ok {
+		return nil, ErrServerNotFound
+	}
+
+	gs, err := bc.ListGattServices(ctx, address, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	services := make([]GattService, 0, len(gs))
+	for _, s := range gs {
+		services = append(services, grpcServiceToClientService(s))
+	}
+
+	return services, nil
+}
+
+// ReadCharacteristic reads the characteristic with the id, e.g. service000a/char000b, or UUID, e.g. 2a19
+func (c *Client) ReadCharacteristic(ctx context.Context, server, address, characteristic string, opts ...RequestOption) ([]byte, error) {
+	bc, ok := c.servers.client(server)
+	if !ok {
+		return nil, ErrServerNotFound
+	}
+
+	return bc.ReadCharacteristic(ctx, address, characteristic, opts...)
+}
+
+func (c *Client) WriteCharacteristic(ctx context.Context, server, address, characteristic string, value []byte, opts ...RequestOption) error {
+	bc, ok := c.servers.client(server)
+	if !ok {
+		return ErrServerNotFound
+	}
+
+	return bc.WriteCharacteristic(ctx, address, characteristic, value, opts...)
+}
+
+// WatchCharacteristic sends every value the device notifies for the characteristic on the returned
+// channel. The channel is closed when the device disconnects or ctx is done.
+func (c *Client) WatchCharacteristic(ctx context.Context, server, address, characteristic string, opts ...RequestOption) (<-chan CharacteristicValue, error) {
+	bc, ok := c.servers.client(server)
+	if !ok {
+		return nil, ErrServerNotFound
+	}
+
+	updates, err := bc.WatchCharacteristic(ctx, address, characteristic, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	ch := make(chan CharacteristicValue, 10)
+	go func() {
+		defer close(ch)
+		for v := range updates {
+			select {
+			case ch <- CharacteristicValue{Host: server, Address: v.Address, CharacteristicID: v.CharacteristicId, Value: v.Value}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch, nil
+}
+
+func grpcServiceToClientService(s *grpc.GattService) GattService {
+	gs := GattService{
+		ID:      s.Id,
+		Profile: Profile{UUID: s.GetProfile().GetUuid(), Name: s.GetProfile().GetName()},
+		Primary: s.Primary,
+	}
+	for _, c := range s.Characteristics {
+		gc := GattCharacteristic{ID: c.Id, UUID: c.Uuid, Flags: c.Flags}
+		for _, d := range c.Descriptors {
+			gc.Descriptors = append(gc.Descriptors, GattDescriptor{ID: d.Id, UUID: d.Uuid, Flags: d.Flags})
+		}
+		gs.Characteristics = append(gs.Characteristics, gc)
+	}
+
+	return gs
+}
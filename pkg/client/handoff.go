@@ -0,0 +1,138 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/andree-bjorkgard/remote-bluetooth/internal/bluetooth"
+)
+
+const (
+	handoffConfirmTimeout  = 10 * time.Second
+	handoffConnectAttempts = 3
+	handoffRetryDelay      = 2 * time.Second
+)
+
+var (
+	ErrDisconnectNotConfirmed = bluetooth.ErrDisconnectNotConfirmed
+)
+
+type HandoffPhase int
+
+const (
+	HandoffDisconnecting HandoffPhase = iota
+	HandoffDisconnected
+	HandoffConnecting
+	HandoffConnected
+	HandoffRollingBack
+	HandoffRolledBack
+	HandoffFailed
+)
+
+func (p HandoffPhase) String() string {
+	switch p {
+	case HandoffDisconnecting:
+		return "disconnecting"
+	case HandoffDisconnected:
+		return "disconnected"
+	case HandoffConnecting:
+		return "connecting"
+	case HandoffConnected:
+		return "connected"
+	case HandoffRollingBack:
+		return "rolling back"
+	case HandoffRolledBack:
+		return "rolled back"
+	case HandoffFailed:
+		return "failed"
+	}
+
+	return "unknown"
+}
+
+// HandoffEvent reports the progress of a handoff. Attempt is set for HandoffConnecting,
+// Err is set when the phase was entered because of an error.
+type HandoffEvent struct {
+	Address string
+	From    string
+	To      string
+	Phase   HandoffPhase
+	Attempt int
+	Err     error
+}
+
+// HandoffDevice moves a device from one server to another. The device is disconnected from fromServer,
+// and once the disconnect is confirmed it is connected on toServer, retrying a few times. If the disconnect is
+// not confirmed or the target never connects, the device is reconnected on fromServer.
+// Progress is reported on the returned channel, which is closed once the handoff has finished.
+// The last event is HandoffConnected on success, HandoffRolledBack or HandoffFailed otherwise.
+// Cancelling ctx stops retrying, but the device is still rolled back to fromServer.
+func (c *Client) HandoffDevice(ctx context.Context, address, fromServer, toServer string) <-chan HandoffEvent {
+	ch := make(chan HandoffEvent, 10)
+
+	go func() {
+		defer close(ch)
+
+		report := func(phase HandoffPhase, attempt int, err error) {
+			ch <- HandoffEvent{Address: address, From: fromServer, To: toServer, Phase: phase, Attempt: attempt, Err: err}
+		}
+
+		from, ok := c.servers.client(fromServer)
+		if !ok {
+			report(HandoffFailed, 0, ErrServerNotFound)
+			return
+		}
+		to, ok := c.servers.client(toServer)
+		if !ok {
+			report(HandoffFailed, 0, ErrServerNotFound)
+			return
+		}
+
+		// The rollback must not be skipped because the caller gave up waiting
+		rollback := func(err error) {
+			report(HandoffRollingBack, 0, err)
+			if rbErr := from.ConnectToDevice(context.WithoutCancel(ctx), address); rbErr != nil {
+				report(HandoffFailed, 0, errors.Join(err, rbErr))
+				return
+			}
+			report(HandoffRolledBack, 0, err)
+		}
+
+		report(HandoffDisconnecting, 0, nil)
+		if err := from.DisconnectFromDevice(ctx, address); err != nil {
+			report(HandoffFailed, 0, err)
+			return
+		}
+		// The disconnect went through, so the device may be connected nowhere unless it is rolled back
+		confirmCtx, cancel := context.WithTimeout(ctx, c.handoffConfirmTimeout)
+		err := from.WaitForDisconnect(confirmCtx, address)
+		cancel()
+		if err != nil {
+			rollback(err)
+			return
+		}
+		report(HandoffDisconnected, 0, nil)
+
+	attempts:
+		for attempt := 1; attempt <= handoffConnectAttempts; attempt++ {
+			if attempt > 1 {
+				select {
+				case <-ctx.Done():
+					break attempts
+				case <-time.After(c.handoffRetryDelay):
+				}
+			}
+
+			report(HandoffConnecting, attempt, err)
+			if err = to.ConnectToDevice(ctx, address); err == nil {
+				report(HandoffConnected, attempt, nil)
+				return
+			}
+		}
+
+		rollback(err)
+	}()
+
+	return ch
+}
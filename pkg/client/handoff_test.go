@@ -0,0 +1,161 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/andree-bjorkgard/remote-bluetooth/internal/bluetooth/fake"
+)
+
+const handoffAddress = "AA:AA:AA:AA:AA:01"
+
+// handoffClient finds the servers a and b. The device is connected on a and known to b.
+func handoffClient(t *testing.T) (c *Client, onA, onB *fake.Device) {
+	t.Helper()
+
+	onA = fake.NewDevice(fake.Properties{Address: handoffAddress, Name: "Headphones", Trusted: true, Paired: true, Connected: true})
+	onB = fake.NewDevice(fake.Properties{Address: handoffAddress, Name: "Headphones", Trusted: true, Paired: true})
+	servers := map[string]*testServer{
+		"a": newTestServer(t, fake.NewAdapter(onA)),
+		"b": newTestServer(t, fake.NewAdapter(onB)),
+	}
+	c = testClient(t, servers, time.Minute)
+	c.handoffRetryDelay = 10 * time.Millisecond
+
+	waitForServerEvent(t, c, ServerAdded)
+	waitForServerEvent(t, c, ServerAdded)
+
+	// Nothing else is checked on the event channels, so the client must not block on them
+	go func() {
+		for range c.GetDeviceEventsChannel() {
+		}
+	}()
+	go func() {
+		for range c.GetServerEventsChannel() {
+		}
+	}()
+
+	return c, onA, onB
+}
+
+// handoffEvents returns every event of the handoff
+func handoffEvents(t *testing.T, ch <-chan HandoffEvent) []HandoffEvent {
+	t.Helper()
+
+	var events []HandoffEvent
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case e, ok := <-ch:
+			if !ok {
+				return events
+			}
+			events = append(events, e)
+		case <-timeout:
+			t.Fatalf("handoff did not finish, events so far: %v", events)
+		}
+	}
+}
+
+func phases(events []HandoffEvent) []HandoffPhase {
+	var ps []HandoffPhase
+	for _, e := range events {
+		ps = append(ps, e.Phase)
+	}
+
+	return ps
+}
+
+func assertPhases(t *testing.T, events []HandoffEvent, want ...HandoffPhase) {
+	t.Helper()
+
+	if got := phases(events); !slices.Equal(got, want) {
+		t.Fatalf("phases = %v, want %v", got, want)
+	}
+}
+
+func TestHandoffDevice(t *testing.T) {
+	c, onA, onB := handoffClient(t)
+
+	events := handoffEvents(t, c.HandoffDevice(context.Background(), handoffAddress, "passthrough:///a", "passthrough:///b"))
+	assertPhases(t, events, HandoffDisconnecting, HandoffDisconnected, HandoffConnecting, HandoffConnected)
+	if events[2].Attempt != 1 {
+		t.Fatalf("connected on attempt %d, want 1", events[2].Attempt)
+	}
+	if onA.Properties().Connected || !onB.Properties().Connected {
+		t.Fatal("device was not moved to b")
+	}
+}
+
+func TestHandoffDeviceRetriesAndRollsBack(t *testing.T) {
+	c, onA, onB := handoffClient(t)
+	onB.FailConnect(errors.New("page timeout"))
+
+	events := handoffEvents(t, c.HandoffDevice(context.Background(), handoffAddress, "passthrough:///a", "passthrough:///b"))
+	assertPhases(t, events, HandoffDisconnecting, HandoffDisconnected,
+		HandoffConnecting, HandoffConnecting, HandoffConnecting, HandoffRollingBack, HandoffRolledBack)
+	for i, e := range events[2:5] {
+		if e.Attempt != i+1 {
+			t.Fatalf("attempt %d reported as %d", i+1, e.Attempt)
+		}
+	}
+	if events[len(events)-1].Err == nil {
+		t.Fatal("rollback does not report why the handoff failed")
+	}
+	if !onA.Properties().Connected || onB.Properties().Connected {
+		t.Fatal("device was not rolled back to a")
+	}
+}
+
+func TestHandoffDeviceRollsBackAfterCancel(t *testing.T) {
+	c, onA, onB := handoffClient(t)
+	onB.FailConnect(errors.New("page timeout"))
+	c.handoffRetryDelay = time.Minute
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ch := c.HandoffDevice(ctx, handoffAddress, "passthrough:///a", "passthrough:///b")
+
+	var events []HandoffEvent
+	for e := range ch {
+		events = append(events, e)
+		if e.Phase == HandoffConnecting {
+			cancel()
+		}
+	}
+	assertPhases(t, events, HandoffDisconnecting, HandoffDisconnected, HandoffConnecting, HandoffRollingBack, HandoffRolledBack)
+	if !onA.Properties().Connected {
+		t.Fatal("device was not rolled back to a after the handoff was cancelled")
+	}
+}
+
+func TestHandoffDeviceRollsBackUnconfirmedDisconnect(t *testing.T) {
+	c, onA, onB := handoffClient(t)
+	onA.KeepConnected(true)
+	c.handoffConfirmTimeout = 200 * time.Millisecond
+
+	events := handoffEvents(t, c.HandoffDevice(context.Background(), handoffAddress, "passthrough:///a", "passthrough:///b"))
+	assertPhases(t, events, HandoffDisconnecting, HandoffRollingBack, HandoffRolledBack)
+	if err := events[len(events)-1].Err; !errors.Is(err, ErrDisconnectNotConfirmed) {
+		t.Fatalf("rollback error = %v, want ErrDisconnectNotConfirmed", err)
+	}
+	if !onA.Properties().Connected || onB.Properties().Connected {
+		t.Fatal("device was not kept on a")
+	}
+}
+
+func TestHandoffDeviceUnknownServer(t *testing.T) {
+	c, onA, _ := handoffClient(t)
+
+	events := handoffEvents(t, c.HandoffDevice(context.Background(), handoffAddress, "passthrough:///a", "passthrough:///c"))
+	assertPhases(t, events, HandoffFailed)
+	if !errors.Is(events[0].Err, ErrServerNotFound) {
+		t.Fatalf("error = %v, want ErrServerNotFound", events[0].Err)
+	}
+	if !onA.Properties().Connected {
+		t.Fatal("device was disconnected although the target is unknown")
+	}
+}
@@ -0,0 +1,38 @@
+package client
+
+import (
+	"context"
+
+	"github.com/andree-bjorkgard/remote-bluetooth/internal/bluetooth/grpc"
+)
+
+// Health tells whether Bluetooth is usable on a server
+type Health struct {
+	Host string
+	// Degraded is set while Bluetooth is unavailable or the server has no adapter, Reason tells which
+	Degraded bool
+	Reason   string
+	// BluetoothAvailable is whether bluetoothd is running on the server
+	BluetoothAvailable bool
+	AdapterIDs         []string
+}
+
+func (c *Client) GetHealth(ctx context.Context, server string) (Health, error) {
+	bc, ok := c.servers.client(server)
+	if !ok {
+		return Health{}, ErrServerNotFound
+	}
+
+	h, err := bc.Health(ctx)
+	if err != nil {
+		return Health{}, err
+	}
+
+	return Health{
+		Host:               server,
+		Degraded:           h.Status == grpc.Health_DEGRADED,
+		Reason:             h.Reason,
+		BluetoothAvailable: h.BluetoothAvailable,
+		AdapterIDs:         h.AdapterIds,
+	}, nil
+}
@@ -0,0 +1,82 @@
+package client
+
+import (
+	"context"
+
+	"github.com/andree-bjorkgard/remote-bluetooth/internal/bluetooth/grpc"
+)
+
+// DeviceFilter selects devices in ListDevices. Nil and empty fields match every device.
+type DeviceFilter struct {
+	Trusted    *bool
+	Paired     *bool
+	Connected  *bool
+	Blocked    *bool
+	HasBattery *bool
+
+	// Name is a case insensitive substring of the name or the alias
+	Name string
+	// Icons match the icon of the device, e.g. "input-keyboard"
+	Icons []string
+	// MajorClasses match the major class of the class of device, e.g. 5 for peripherals
+	MajorClasses []uint32
+	// AdapterIDs match the adapter the device belongs to, e.g. "hci1"
+	AdapterIDs []string
+}
+
+type DeviceSort int
+
+const (
+	SortByName DeviceSort = iota
+	SortByAddress
+	// Connected devices first
+	SortByConnected
+	// Lowest battery first, devices without a known battery level last
+	SortByBattery
+)
+
+var deviceSorts = map[DeviceSort]grpc.ListDevicesRequest_SortBy{
+	SortByName:      grpc.ListDevicesRequest_NAME,
+	SortByAddress:   grpc.ListDevicesRequest_ADDRESS,
+	SortByConnected: grpc.ListDevicesRequest_CONNECTED,
+	SortByBattery:   grpc.ListDevicesRequest_BATTERY,
+}
+
+// Bool returns a pointer to b, for the fields of DeviceFilter
+func Bool(b bool) *bool {
+	return &b
+}
+
+// ListDevices returns the devices known to the server that match the filter, not just the trusted ones.
+func (c *Client) ListDevices(ctx context.Context, server string, filter DeviceFilter, sortBy DeviceSort, descending bool) ([]Device, error) {
+	bc, ok := c.servers.client(server)
+	if !ok {
+		return nil, ErrServerNotFound
+	}
+
+	ds, err := bc.ListDevices(ctx, &grpc.ListDevicesRequest{
+		Filter: &grpc.DeviceFilter{
+			Trusted:      filter.Trusted,
+			Paired:       filter.Paired,
+			Connected:    filter.Connected,
+			Blocked:      filter.Blocked,
+			HasBattery:   filter.HasBattery,
+			Name:         filter.Name,
+			Icons:        filter.Icons,
+			MajorClasses: filter.MajorClasses,
+			AdapterIds:   filter.AdapterIDs,
+		},
+		SortBy:     deviceSorts[sortBy],
+		Descending: descending,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	devs := make([]Device, 0, len(ds))
+	for _, d := range ds {
+		devs = append(devs, *grpcDeviceToClientDevice(d, server))
+	}
+
+	return devs, nil
+}
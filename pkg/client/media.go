@@ -0,0 +1,301 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	"github.com/andree-bjorkgard/remote-bluetooth/internal/bluetooth"
+	"github.com/andree-bjorkgard/remote-bluetooth/internal/bluetooth/grpc"
+)
+
+type PlaybackStatus int
+
+const (
+	PlaybackStopped PlaybackStatus = iota
+	PlaybackPlaying
+	PlaybackPaused
+	PlaybackForwardSeek
+	PlaybackReverseSeek
+	// The player reported an error
+	PlaybackError
+)
+
+func (s PlaybackStatus) String() string {
+	switch s {
+	case PlaybackStopped:
+		return "stopped"
+	case PlaybackPlaying:
+		return "playing"
+	case PlaybackPaused:
+		return "paused"
+	case PlaybackForwardSeek:
+		return "forward seek"
+	case PlaybackReverseSeek:
+		return "reverse seek"
+	case PlaybackError:
+		return "error"
+	}
+
+	return "unknown"
+}
+
+// Track is the metadata the device reports for the playing track, fields it does not report are empty
+type Track struct {
+	Title          string
+	Artist         string
+	Album          string
+	Genre          string
+	TrackNumber    uint32
+	NumberOfTracks uint32
+	Duration       time.Duration
+}
+
+// NowPlaying is what the media player of a device is playing
+type NowPlaying struct {
+	Host    string
+	Address string
+	Status  PlaybackStatus
+	Track   Track
+	// Position is where the track was when the server sent it. Devices only report it when the status or
+	// track changes, so it has to be advanced locally while playing.
+	Position time.Duration
+	// Player is the name of the application playing on the device, if it reports one
+	Player string
+}
+
+func (c *Client) MediaPlay(ctx context.Context, server, address string, opts ...RequestOption) error {
+	return c.onPlayer(server, func(bc *bluetooth.BluetoothClient) error {
+		return bc.MediaPlay(ctx, address, opts...)
+	})
+}
+
+func (c *Client) MediaPause(ctx context.Context, server, address string, opts ...RequestOption) error {
+	return c.onPlayer(server, func(bc *bluetooth.BluetoothClient) error {
+		return bc.MediaPause(ctx, address, opts...)
+	})
+}
+
+func (c *Client) MediaNext(ctx context.Context, server, address string, opts ...RequestOption) error {
+	return c.onPlayer(server, func(bc *bluetooth.BluetoothClient) error {
+		return bc.MediaNext(ctx, address, opts...)
+	})
+}
+
+func (c *Client) MediaPrevious(ctx context.Context, server, address string, opts ...RequestOption) error {
+	return c.onPlayer(server, func(bc *bluetooth.BluetoothClient) error {
+		return bc.MediaPrevious(ctx, address, opts...)
+	})
+}
+
+// MediaVolumeUp asks the device to raise its volume by one step, the size of which is up to the device
+func (c *Client) MediaVolumeUp(ctx context.Context, server, address string, opts ...RequestOption) error {
+	return c.onPlayer(server, func(bc *bluetooth.BluetoothClient) error {
+		return bc.MediaVolumeUp(ctx, address, opts...)
+	})
+}
+
+func (c *Client) MediaVolumeDown(ctx context.Context, server, address string, opts ...RequestOption) error {
+	return c.onPlayer(server, func(bc *bluetooth.BluetoothClient) error {
+		return bc.MediaVolumeDown(ctx, address, opts...)
+	})
+}
+
+func (c *Client) onPlayer(server string, fn func(bc *bluetooth.BluetoothClient) error) error {
+	bc, ok := c.servers.client(server)
+	if !ok {
+		return ErrServerNotFound
+	}
+
+	return fn(bc)
+}
+
+func (c *Client) GetNowPlaying(ctx context.Context, server, address string, opts ...RequestOption) (NowPlaying, error) {
+	bc, ok := c.servers.client(server)
+	if !ok {
+		return NowPlaying{}, ErrServerNotFound
+	}
+
+	np, err := bc.GetNowPlaying(ctx, address, opts...)
+	if err != nil {
+		return NowPlaying{}, err
+	}
+
+	return grpcNowPlayingToClientNowPlaying(np, server), nil
+}
+
+// WatchNowPlaying sends what the device is playing on the returned channel, first right away and then
+// every time it changes. The channel is closed when the device has no player left or ctx is done.
+func (c *Client) WatchNowPlaying(ctx context.Context, server, address string, opts ...RequestOption) (<-chan NowPlaying, error) {
+	bc, ok := c.servers.client(server)
+	if !ok {
+		return nil, ErrServerNotFound
+	}
+
+	updates, err := bc.WatchNowPlaying(ctx, address, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	ch := make(chan NowPlaying, 10)
+	go func() {
+		defer close(ch)
+		for np := range updates {
+			select {
+			case ch <- grpcNowPlayingToClientNowPlaying(np, server):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch, nil
+}
+
+func grpcNowPlayingToClientNowPlaying(np *grpc.NowPlaying, server string) NowPlaying {
+	t := np.GetTrack()
+
+	return NowPlaying{
+		Host:    server,
+		Address: np.Address,
+		Status:  PlaybackStatus(np.Status),
+		Track: Track{
+			Title:          t.GetTitle(),
+			Artist:         t.GetArtist(),
+			Album:          t.GetAlbum(),
+			Genre:          t.GetGenre(),
+			TrackNumber:    t.GetTrackNumber(),
+			NumberOfTracks: t.GetNumberOfTracks(),
+			Duration:       time.Duration(t.GetDuration()) * time.Millisecond,
+		},
+		Position: time.Duration(np.Position) * time.Millisecond,
+		Player:   np.Player,
+	}
+}
+
+type TransportState int
+
+const (
+	TransportIdle TransportState = iota
+	TransportPending
+	// Audio is streaming
+	TransportActive
+)
+
+func (s TransportState) String() string {
+	switch s {
+	case TransportIdle:
+		return "idle"
+	case TransportPending:
+		return "pending"
+	case TransportActive:
+		return "active"
+	}
+
+	return "unknown"
+}
+
+// MediaTransport is an audio stream between a server and a device, there is one for every connected
+// audio profile
+type MediaTransport struct {
+	Host    string
+	Address string
+	// ID identifies the transport on the device, e.g. sep1/fd0. It changes when the profile reconnects.
+	ID      string
+	Profile Profile
+	State   TransportState
+	Codec   byte
+	// Volume is between 0 and 127, nil if the device does not support absolute volume
+	Volume *uint16
+}
+
+func (c *Client) ListMediaTransports(ctx context.Context, server, address string, opts ...RequestOption) ([]MediaTransport, error) {
+	bc, ok := c.servers.client(server)
+	if !ok {
+		return nil, ErrServerNotFound
+	}
+
+	ts, err := bc.ListMediaTransports(ctx, address, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	transports := make([]MediaTransport, 0, len(ts))
+	for _, t := range ts {
+		transports = append(transports, grpcTransportToClientTransport(t, server))
+	}
+
+	return transports, nil
+}
+
+// GetVolume returns the first transport of the device with a volume, or the one picked with OnTransport
+func (c *Client) GetVolume(ctx context.Context, server, address string, opts ...RequestOption) (MediaTransport, error) {
+	return c.onTransport(server, func(bc *bluetooth.BluetoothClient) (*grpc.MediaTransport, error) {
+		return bc.GetVolume(ctx, address, opts...)
+	})
+}
+
+// SetVolume sets the absolute volume of the device, between 0 and 127
+func (c *Client) SetVolume(ctx context.Context, server, address string, volume uint16, opts ...RequestOption) (MediaTransport, error) {
+	return c.onTransport(server, func(bc *bluetooth.BluetoothClient) (*grpc.MediaTransport, error) {
+		return bc.SetVolume(ctx, address, volume, opts...)
+	})
+}
+
+// WatchVolume sends every transport of the device on the returned channel and then every transport whose
+// volume changes. The channel is closed when the device has no transport left or ctx is done.
+func (c *Client) WatchVolume(ctx context.Context, server, address string, opts ...RequestOption) (<-chan MediaTransport, error) {
+	bc, ok := c.servers.client(server)
+	if !ok {
+		return nil, ErrServerNotFound
+	}
+
+	updates, err := bc.WatchVolume(ctx, address, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	ch := make(chan MediaTransport, 10)
+	go func() {
+		defer close(ch)
+		for t := range updates {
+			select {
+			case ch <- grpcTransportToClientTransport(t, server):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch, nil
+}
+
+func (c *Client) onTransport(server string, fn func(bc *bluetooth.BluetoothClient) (*grpc.MediaTransport, error)) (MediaTransport, error) {
+	bc, ok := c.servers.client(server)
+	if !ok {
+		return MediaTransport{}, ErrServerNotFound
+	}
+
+	t, err := fn(bc)
+	if err != nil {
+		return MediaTransport{}, err
+	}
+
+	return grpcTransportToClientTransport(t, server), nil
+}
+
+func grpcTransportToClientTransport(t *grpc.MediaTransport, server string) MediaTransport {
+	mt := MediaTransport{
+		Host:    server,
+		Address: t.Address,
+		ID:      t.Id,
+		Profile: Profile{UUID: t.GetProfile().GetUuid(), Name: t.GetProfile().GetName()},
+		State:   TransportState(t.State),
+		Codec:   byte(t.Codec),
+	}
+	if t.Volume != nil {
+		v := uint16(*t.Volume)
+		mt.Volume = &v
+	}
+
+	return mt
+}
@@ -0,0 +1,158 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+
+	"github.com/andree-bjorkgard/remote-bluetooth/internal/bluetooth"
+)
+
+// Server is a discovered server
+type Server struct {
+	Address string
+	ID      string
+	Name    string
+}
+
+type ServerEventType int
+
+const (
+	ServerAdded ServerEventType = iota
+	// ServerLost is sent once a server stayed unreachable for a while, not on every dropped connection
+	ServerLost
+)
+
+type ServerEvent struct {
+	Type   ServerEventType
+	Server Server
+}
+
+type serverEntry struct {
+	server  Server
+	client  *bluetooth.BluetoothClient
+	devices map[string]Device
+	// cancel stops the watchers of the server
+	cancel context.CancelFunc
+}
+
+// serverRegistry holds the connections to all discovered servers and the last known state of their devices
+type serverRegistry struct {
+	mu      sync.RWMutex
+	servers map[string]*serverEntry
+}
+
+func newServerRegistry() *serverRegistry {
+	return &serverRegistry{servers: make(map[string]*serverEntry)}
+}
+
+func (r *serverRegistry) has(addr string) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	_, ok := r.servers[addr]
+	return ok
+}
+
+func (r *serverRegistry) add(server Server, bc *bluetooth.BluetoothClient, cancel context.CancelFunc) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.servers[server.Address] = &serverEntry{server: server, client: bc, devices: make(map[string]Device), cancel: cancel}
+}
+
+func (r *serverRegistry) remove(addr string) (Server, bool) {
+	r.mu.Lock()
+	e, ok := r.servers[addr]
+	delete(r.servers, addr)
+	r.mu.Unlock()
+
+	if !ok {
+		return Server{}, false
+	}
+	e.cancel()
+	e.client.Close()
+
+	return e.server, true
+}
+
+func (r *serverRegistry) client(addr string) (*bluetooth.BluetoothClient, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	e, ok := r.servers[addr]
+	if !ok {
+		return nil, false
+	}
+
+	return e.client, true
+}
+
+func (r *serverRegistry) setDevice(addr string, d *Device) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if e, ok := r.servers[addr]; ok {
+		e.devices[d.Address] = *d
+	}
+}
+
+func (r *serverRegistry) server(addr string) (Server, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	e, ok := r.servers[addr]
+	if !ok {
+		return Server{}, false
+	}
+
+	return e.server, true
+}
+
+func (r *serverRegistry) list() []Server {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	servers := make([]Server, 0, len(r.servers))
+	for _, e := range r.servers {
+		servers = append(servers, e.server)
+	}
+	sort.Slice(servers, func(i, j int) bool { return servers[i].Address < servers[j].Address })
+
+	return servers
+}
+
+func (r *serverRegistry) devices() []Device {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var devs []Device
+	for _, e := range r.servers {
+		for _, d := range e.devices {
+			devs = append(devs, d)
+		}
+	}
+	sort.Slice(devs, func(i, j int) bool {
+		if devs[i].Host != devs[j].Host {
+			return devs[i].Host < devs[j].Host
+		}
+		return devs[i].Address < devs[j].Address
+	})
+
+	return devs
+}
+
+func (r *serverRegistry) closeAll() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var errs []error
+	for addr, e := range r.servers {
+		e.cancel()
+		errs = append(errs, e.client.Close())
+		delete(r.servers, addr)
+	}
+
+	return errors.Join(errs...)
+}
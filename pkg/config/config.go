@@ -1,8 +1,13 @@
 package config
 
 import (
+	"fmt"
+	"log"
 	"os"
+	"path/filepath"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/andree-bjorkgard/remote-bluetooth/internal/util"
 	_ "github.com/joho/godotenv/autoload"
@@ -10,11 +15,38 @@ import (
 
 type Config struct {
 	// Server
-	Port                 int
+	Port int
+	// AuthenticationSecret is accepted from every client in addition to paired client tokens, unless empty
 	AuthenticationSecret string
+	// ClientsFile is where the server keeps the clients paired with it
+	ClientsFile string
+	// ACLFile restricts what each client may do, everything is allowed if empty
+	ACLFile string
+
+	// Client
+	// TokensFile is where the client keeps the tokens issued to it by servers
+	TokensFile string
+	// RequestTimeout bounds requests made without a deadline, ConnectTimeout does the same for connecting
+	// and disconnecting devices. Zero disables the default deadline.
+	RequestTimeout time.Duration
+	ConnectTimeout time.Duration
+
+	// TLS, enabled on the server when a certificate and key are set and on the client when a CA or certificate is set.
+	// Setting the CA on the server requires clients to present a certificate signed by it.
+	TLSCertFile   string
+	TLSKeyFile    string
+	TLSCAFile     string
+	TLSServerName string
 
 	// Bluetooth
+	// AdapterID is the default adapter, used by requests that name none. Every adapter is managed either way.
 	AdapterID string
+	// BatterySampleInterval is how often the battery levels of connected devices are recorded,
+	// BatteryHistory how long they are kept
+	BatterySampleInterval time.Duration
+	BatteryHistory        time.Duration
+	// BatteryThresholds are the percentages at which clients are alerted about a low battery
+	BatteryThresholds []byte
 
 	// Discovery
 	BroadcastPort           int
@@ -25,6 +57,13 @@ type Config struct {
 const broadcastMessage = "bt-discovery"
 const broadcastServerResponse = "bt-discovery-server"
 
+const defaultRequestTimeout = 10 * time.Second
+const defaultConnectTimeout = 30 * time.Second
+
+const defaultBatterySampleInterval = 5 * time.Minute
+const defaultBatteryHistory = 24 * time.Hour
+const defaultBatteryThresholds = "20,10,5"
+
 func NewConfig() Config {
 	var port int
 	var err error
@@ -65,13 +104,90 @@ func NewConfig() Config {
 
 	adapterID := os.Getenv("REMOTE_BLUETOOTH_ADAPTER_ID")
 
+	clientsFile := fileFromEnv("REMOTE_BLUETOOTH_CLIENTS_FILE", "clients.json")
+	tokensFile := fileFromEnv("REMOTE_BLUETOOTH_TOKENS_FILE", "tokens.json")
+
+	requestTimeout := durationFromEnv("REMOTE_BLUETOOTH_REQUEST_TIMEOUT", defaultRequestTimeout)
+	connectTimeout := durationFromEnv("REMOTE_BLUETOOTH_CONNECT_TIMEOUT", defaultConnectTimeout)
+
 	return Config{
 		Port:                 port,
 		AuthenticationSecret: secret,
-		AdapterID:            adapterID,
+		ClientsFile:          clientsFile,
+		ACLFile:              os.Getenv("REMOTE_BLUETOOTH_ACL_FILE"),
+		TokensFile:           tokensFile,
+		RequestTimeout:       requestTimeout,
+		ConnectTimeout:       connectTimeout,
+
+		TLSCertFile:   os.Getenv("REMOTE_BLUETOOTH_TLS_CERT"),
+		TLSKeyFile:    os.Getenv("REMOTE_BLUETOOTH_TLS_KEY"),
+		TLSCAFile:     os.Getenv("REMOTE_BLUETOOTH_TLS_CA"),
+		TLSServerName: os.Getenv("REMOTE_BLUETOOTH_TLS_SERVER_NAME"),
+
+		AdapterID:             adapterID,
+		BatterySampleInterval: durationFromEnv("REMOTE_BLUETOOTH_BATTERY_SAMPLE_INTERVAL", defaultBatterySampleInterval),
+		BatteryHistory:        durationFromEnv("REMOTE_BLUETOOTH_BATTERY_HISTORY", defaultBatteryHistory),
+		BatteryThresholds:     thresholdsFromEnv("REMOTE_BLUETOOTH_BATTERY_THRESHOLDS", defaultBatteryThresholds),
 
 		BroadcastPort:           broadcastPort,
 		BroadcastMessage:        []byte(msg),
 		BroadcastServerResponse: []byte(serverMsg),
 	}
 }
+
+// fileFromEnv returns the path in the environment variable, or name in the user's config directory if it is
+// unset. Without a config directory, e.g. for a system service without $HOME, the path is left empty and
+// loading the file fails.
+func fileFromEnv(key, name string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		log.Printf("config: %s is not set and there is no default: %s", key, err)
+		return ""
+	}
+
+	return filepath.Join(dir, "remote-bluetooth", name)
+}
+
+// durationFromEnv parses a duration like "30s" from the environment variable, or returns def if it is unset
+func durationFromEnv(key string, def time.Duration) time.Duration {
+	v := os.Getenv(key)
+	if v == "" {
+		return def
+	}
+
+	d, err := time.ParseDuration(v)
+	if err != nil {
+		panic(err)
+	}
+
+	return d
+}
+
+// thresholdsFromEnv parses comma separated percentages like "20,10,5", "off" disables them
+func thresholdsFromEnv(key string, def string) []byte {
+	v := os.Getenv(key)
+	if v == "" {
+		v = def
+	}
+	if v == "off" {
+		return nil
+	}
+
+	var thresholds []byte
+	for _, t := range strings.Split(v, ",") {
+		p, err := strconv.ParseUint(strings.TrimSpace(t), 10, 8)
+		if err != nil {
+			panic(err)
+		}
+		if p > 100 {
+			panic(fmt.Sprintf("%s: %d is not a percentage", key, p))
+		}
+		thresholds = append(thresholds, byte(p))
+	}
+
+	return thresholds
+}
@@ -0,0 +1,18 @@
+package config
+
+import "testing"
+
+func TestNewConfigWithoutHome(t *testing.T) {
+	t.Setenv("HOME", "")
+	t.Setenv("XDG_CONFIG_HOME", "")
+	t.Setenv("REMOTE_BLUETOOTH_CLIENTS_FILE", "/etc/remote-bluetooth/clients.json")
+	t.Setenv("REMOTE_BLUETOOTH_TOKENS_FILE", "")
+
+	cfg := NewConfig()
+	if cfg.ClientsFile != "/etc/remote-bluetooth/clients.json" {
+		t.Fatalf("ClientsFile = %q", cfg.ClientsFile)
+	}
+	if cfg.TokensFile != "" {
+		t.Fatalf("TokensFile = %q, want none without a config directory", cfg.TokensFile)
+	}
+}
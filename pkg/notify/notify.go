@@ -0,0 +1,132 @@
+// Package notify shows desktop notifications through the freedesktop org.freedesktop.Notifications service.
+package notify
+
+import (
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/godbus/dbus/v5"
+
+	"github.com/andree-bjorkgard/remote-bluetooth/pkg/client"
+)
+
+const (
+	notificationsName      = "org.freedesktop.Notifications"
+	notificationsPath      = "/org/freedesktop/Notifications"
+	notificationsInterface = "org.freedesktop.Notifications"
+)
+
+type Urgency byte
+
+const (
+	Low Urgency = iota
+	Normal
+	Critical
+)
+
+// Notifier sends notifications on a session bus. Notifications with the same key replace each other,
+// so a device running low keeps a single notification.
+type Notifier struct {
+	conn    *dbus.Conn
+	appName string
+
+	mu  sync.Mutex
+	ids map[string]uint32
+}
+
+// New connects to the session bus of the user
+func New(appName string) (*Notifier, error) {
+	conn, err := dbus.SessionBusPrivate()
+	if err != nil {
+		return nil, fmt.Errorf("notify.New: %w", err)
+	}
+
+	return connect(conn, appName)
+}
+
+// NewWithAddress connects to the bus at address, e.g. a private session bus started for tests
+func NewWithAddress(address, appName string) (*Notifier, error) {
+	conn, err := dbus.Dial(address)
+	if err != nil {
+		return nil, fmt.Errorf("notify.NewWithAddress: %w", err)
+	}
+
+	return connect(conn, appName)
+}
+
+func connect(conn *dbus.Conn, appName string) (*Notifier, error) {
+	if err := conn.Auth(nil); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("notify.connect: %w", err)
+	}
+	if err := conn.Hello(); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("notify.connect: %w", err)
+	}
+
+	return &Notifier{conn: conn, appName: appName, ids: make(map[string]uint32)}, nil
+}
+
+func (n *Notifier) Close() error {
+	return n.conn.Close()
+}
+
+// Notify shows a notification, replacing the previous one with the same key. An empty key never replaces.
+func (n *Notifier) Notify(key, summary, body, icon string, urgency Urgency, timeout time.Duration) error {
+	n.mu.Lock()
+	replaces := n.ids[key]
+	n.mu.Unlock()
+
+	hints := map[string]dbus.Variant{"urgency": dbus.MakeVariant(byte(urgency))}
+	expire := int32(-1)
+	if timeout > 0 {
+		expire = int32(timeout.Milliseconds())
+	}
+
+	var id uint32
+	err := n.conn.Object(notificationsName, notificationsPath).Call(notificationsInterface+".Notify", 0,
+		n.appName, replaces, icon, summary, body, []string{}, hints, expire).Store(&id)
+	if err != nil {
+		return fmt.Errorf("Notifier.Notify: %w", err)
+	}
+
+	if key != "" {
+		n.mu.Lock()
+		n.ids[key] = id
+		n.mu.Unlock()
+	}
+
+	return nil
+}
+
+// LowBattery notifies about a low battery, it can be passed to client.OnLowBattery directly.
+// Alerts at or below 10% are critical, which notification servers usually keep until dismissed.
+func (n *Notifier) LowBattery(e client.LowBatteryEvent) {
+	name, _ := e.Device.GetAlias()
+	if name == "" {
+		name = e.Device.Address
+	}
+
+	body := fmt.Sprintf("%d%% left on %s", e.Percentage, e.Server)
+	if e.TimeToEmpty != nil {
+		body = fmt.Sprintf("%d%% left on %s, about %s until empty", e.Percentage, e.Server, e.TimeToEmpty.Round(time.Minute))
+	}
+
+	urgency := Normal
+	if e.Percentage <= 10 {
+		urgency = Critical
+	}
+
+	if err := n.Notify(e.Server+"/"+e.Device.Address, name+" battery low", body, "battery-low", urgency, 0); err != nil {
+		log.Println("Error showing low battery notification: ", err)
+	}
+}
+
+// PairingCode shows the code a client pairing with a server has to confirm, it is what a server shows pairing codes
+// with. The notification expires together with the pairing.
+func (n *Notifier) PairingCode(clientName, code string) error {
+	body := fmt.Sprintf("Enter %s on %s to pair it with this server", code, clientName)
+	return n.Notify("pairing/"+clientName, clientName+" wants to pair", body, "dialog-password", Normal, 2*time.Minute)
+}
@@ -0,0 +1,150 @@
+package notify
+
+import (
+	"bufio"
+	"os/exec"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/godbus/dbus/v5"
+)
+
+// sessionBus starts a private session bus and returns its address, the test is skipped without dbus-daemon
+func sessionBus(t *testing.T) string {
+	t.Helper()
+
+	path, err := exec.LookPath("dbus-daemon")
+	if err != nil {
+		t.Skip("dbus-daemon not installed")
+	}
+
+	cmd := exec.Command(path, "--session", "--nofork", "--print-address=1")
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := cmd.Start(); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		cmd.Process.Kill()
+		cmd.Wait()
+	})
+
+	address, err := bufio.NewReader(stdout).ReadString('\n')
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return strings.TrimSpace(address)
+}
+
+// notificationServer records the notifications it is sent, like a notification daemon would show them
+type notificationServer struct {
+	mu     sync.Mutex
+	nextID uint32
+	shown  []notification
+}
+
+type notification struct {
+	appName  string
+	replaces uint32
+	summary  string
+	body     string
+	urgency  byte
+	expire   int32
+}
+
+func (s *notificationServer) Notify(appName string, replaces uint32, icon, summary, body string, actions []string, hints map[string]dbus.Variant, expire int32) (uint32, *dbus.Error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	n := notification{appName: appName, replaces: replaces, summary: summary, body: body, expire: expire}
+	if v, ok := hints["urgency"]; ok {
+		n.urgency, _ = v.Value().(byte)
+	}
+	s.shown = append(s.shown, n)
+
+	if replaces != 0 {
+		return replaces, nil
+	}
+	s.nextID++
+	return s.nextID, nil
+}
+
+func (s *notificationServer) notifications() []notification {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]notification(nil), s.shown...)
+}
+
+func serveNotifications(t *testing.T, address string) *notificationServer {
+	t.Helper()
+
+	conn, err := dbus.Dial(address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	if err := conn.Auth(nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := conn.Hello(); err != nil {
+		t.Fatal(err)
+	}
+
+	s := &notificationServer{}
+	if err := conn.Export(s, notificationsPath, notificationsInterface); err != nil {
+		t.Fatal(err)
+	}
+	if reply, err := conn.RequestName(notificationsName, dbus.NameFlagDoNotQueue); err != nil || reply != dbus.RequestNameReplyPrimaryOwner {
+		t.Fatalf("requesting %s: %v, %v", notificationsName, reply, err)
+	}
+
+	return s
+}
+
+func TestNewWithAddress(t *testing.T) {
+	address := sessionBus(t)
+	server := serveNotifications(t, address)
+
+	n, err := NewWithAddress(address, "remote-bluetooth")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer n.Close()
+
+	if err := n.Notify("headphones", "Headphones battery low", "20% left", "battery-low", Normal, 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := n.Notify("headphones", "Headphones battery low", "10% left", "battery-low", Critical, 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := n.Notify("", "Speaker", "Connected", "", Low, 5*time.Second); err != nil {
+		t.Fatal(err)
+	}
+
+	shown := server.notifications()
+	if len(shown) != 3 {
+		t.Fatalf("got %d notifications, want 3", len(shown))
+	}
+	if shown[0].appName != "remote-bluetooth" || shown[0].replaces != 0 || shown[0].expire != -1 || shown[0].urgency != byte(Normal) {
+		t.Fatalf("first notification = %+v", shown[0])
+	}
+	// The same key replaces the notification shown before
+	if shown[1].replaces == 0 || shown[1].body != "10% left" || shown[1].urgency != byte(Critical) {
+		t.Fatalf("second notification = %+v, want it to replace the first", shown[1])
+	}
+	if shown[2].replaces != 0 || shown[2].expire != 5000 {
+		t.Fatalf("notification without a key = %+v", shown[2])
+	}
+}
+
+func TestNewWithAddressUnreachable(t *testing.T) {
+	if _, err := NewWithAddress("unix:path=/nonexistent/bus", "remote-bluetooth"); err == nil {
+		t.Fatal("connected to a bus that does not exist")
+	}
+}